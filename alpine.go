@@ -2,7 +2,6 @@
 package alpine
 
 import (
-	"github.com/plainkit/alpine/js"
 	"github.com/plainkit/html"
 )
 
@@ -10,146 +9,154 @@ import (
 
 // XData declares a new Alpine component and its data.
 // Example: XData("{open: false, name: 'Alpine'}")
-func XData(data string) html.Global {
-	return html.ACustom("x-data", data)
+func XData[E Expression](data E) html.Global {
+	return directive("x-data", data)
 }
 
 // XInit runs code when a component initializes.
 // Example: XInit("console.log('Component initialized')")
-func XInit(code string) html.Global {
-	return html.ACustom("x-init", code)
+func XInit[E Expression](code E) html.Global {
+	return directive("x-init", code)
 }
 
 // Display and Rendering
 
 // XShow shows or hides an element based on a condition.
 // Example: XShow("open")
-func XShow(expression string) html.Global {
-	return html.ACustom("x-show", expression)
+func XShow[E Expression](expression E) html.Global {
+	return directive("x-show", expression)
 }
 
 // XIf conditionally renders elements in the DOM.
 // Must be used on a <template> tag.
 // Example: XIf("user.isAdmin")
-func XIf(expression string) html.Global {
-	return html.ACustom("x-if", expression)
+func XIf[E Expression](expression E) html.Global {
+	return directive("x-if", expression)
 }
 
 // XFor creates DOM elements by iterating through a list.
 // Must be used on a <template> tag.
 // Example: XFor("item in items")
 func XFor(expression string) html.Global {
-	return html.ACustom("x-for", expression)
+	return directive("x-for", expression)
 }
 
 // XHtml sets the inner HTML of an element.
 // Example: XHtml("markdownContent")
-func XHtml(expression string) html.Global {
-	return html.ACustom("x-html", expression)
+func XHtml[E Expression](expression E) html.Global {
+	return directive("x-html", expression)
 }
 
 // XText sets the text content of an element.
 // Example: XText("userName")
-func XText(expression string) html.Global {
-	return html.ACustom("x-text", expression)
+func XText[E Expression](expression E) html.Global {
+	return directive("x-text", expression)
 }
 
 // XCloak hides elements until Alpine is initialized.
 // Typically used with CSS: [x-cloak] { display: none !important; }
+// It renders as x-cloak="true" because html.Render drops attributes with
+// empty values; Alpine and the CSS selector ignore the value.
 func XCloak() html.Global {
-	return html.ACustom("x-cloak", "")
+	return html.ACustom("x-cloak", "true")
 }
 
 // Event Handling and Binding
 
 // XOn listens for browser events.
 // Example: XOn("click", "open = !open")
-func XOn(event, handler string) html.Global {
-	return html.ACustom("x-on:"+event, handler)
+func XOn[E Expression](event string, handler E) html.Global {
+	return directive("x-on:"+event, handler)
 }
 
 // XOnClick is a shorthand for XOn("click", handler).
-func XOnClick(handler string) html.Global {
-	return html.ACustom("x-on:click", handler)
+func XOnClick[E Expression](handler E) html.Global {
+	return directive("x-on:click", handler)
 }
 
 // XOnSubmit is a shorthand for XOn("submit", handler).
-func XOnSubmit(handler string) html.Global {
-	return html.ACustom("x-on:submit", handler)
+func XOnSubmit[E Expression](handler E) html.Global {
+	return directive("x-on:submit", handler)
 }
 
 // XOnChange is a shorthand for XOn("change", handler).
-func XOnChange(handler string) html.Global {
-	return html.ACustom("x-on:change", handler)
+func XOnChange[E Expression](handler E) html.Global {
+	return directive("x-on:change", handler)
 }
 
 // XOnInput is a shorthand for XOn("input", handler).
-func XOnInput(handler string) html.Global {
-	return html.ACustom("x-on:input", handler)
+func XOnInput[E Expression](handler E) html.Global {
+	return directive("x-on:input", handler)
 }
 
 // XOnKeydown is a shorthand for XOn("keydown", handler).
-func XOnKeydown(handler string) html.Global {
-	return html.ACustom("x-on:keydown", handler)
+func XOnKeydown[E Expression](handler E) html.Global {
+	return directive("x-on:keydown", handler)
 }
 
 // XOnKeyup is a shorthand for XOn("keyup", handler).
-func XOnKeyup(handler string) html.Global {
-	return html.ACustom("x-on:keyup", handler)
+func XOnKeyup[E Expression](handler E) html.Global {
+	return directive("x-on:keyup", handler)
 }
 
 // XOnMouseenter is a shorthand for XOn("mouseenter", handler).
-func XOnMouseenter(handler string) html.Global {
-	return html.ACustom("x-on:mouseenter", handler)
+func XOnMouseenter[E Expression](handler E) html.Global {
+	return directive("x-on:mouseenter", handler)
 }
 
 // XOnMouseleave is a shorthand for XOn("mouseleave", handler).
-func XOnMouseleave(handler string) html.Global {
-	return html.ACustom("x-on:mouseleave", handler)
+func XOnMouseleave[E Expression](handler E) html.Global {
+	return directive("x-on:mouseleave", handler)
 }
 
 // XBind dynamically sets HTML attributes.
 // Example: XBind("class", "{ 'hidden': !open }")
-func XBind(attribute, expression string) html.Global {
-	return html.ACustom("x-bind:"+attribute, expression)
+func XBind[E Expression](attribute string, expression E) html.Global {
+	return directive("x-bind:"+attribute, expression)
 }
 
 // XBindClass is a shorthand for XBind("class", expression).
-func XBindClass(expression string) html.Global {
-	return html.ACustom("x-bind:class", expression)
+func XBindClass[E Expression](expression E) html.Global {
+	return directive("x-bind:class", expression)
 }
 
 // XBindStyle is a shorthand for XBind("style", expression).
-func XBindStyle(expression string) html.Global {
-	return html.ACustom("x-bind:style", expression)
+func XBindStyle[E Expression](expression E) html.Global {
+	return directive("x-bind:style", expression)
 }
 
 // XBindDisabled is a shorthand for XBind("disabled", expression).
-func XBindDisabled(expression string) html.Global {
-	return html.ACustom("x-bind:disabled", expression)
+func XBindDisabled[E Expression](expression E) html.Global {
+	return directive("x-bind:disabled", expression)
 }
 
 // XBindValue is a shorthand for XBind("value", expression).
-func XBindValue(expression string) html.Global {
-	return html.ACustom("x-bind:value", expression)
+func XBindValue[E Expression](expression E) html.Global {
+	return directive("x-bind:value", expression)
 }
 
 // XModel creates two-way data binding for form inputs.
 // Example: XModel("searchQuery")
-func XModel(expression string) html.Global {
-	return html.ACustom("x-model", expression)
+func XModel[E Expression](expression E) html.Global {
+	return directive("x-model", expression)
 }
 
 // XModelable makes a property modelable from child components.
 // Example: XModelable("value")
-func XModelable(expression string) html.Global {
-	return html.ACustom("x-modelable", expression)
+func XModelable[E Expression](expression E) html.Global {
+	return directive("x-modelable", expression)
 }
 
 // Advanced Directives
 
 // XTransition adds transitions to elements.
 // Can be used with modifiers like x-transition:enter, x-transition:leave
+//
+// Alpine only uses its default transition when x-transition has no value;
+// any value is read as a class list. html.Render drops attributes with
+// empty values, so this helper renders nothing until plainkit/html can
+// emit valueless attributes. Use XTransitionEnter and the other class
+// helpers instead.
 func XTransition() html.Global {
 	return html.ACustom("x-transition", "")
 }
@@ -192,8 +199,8 @@ func XTransitionLeaveEnd(classes string) html.Global {
 
 // XEffect re-evaluates an expression when dependencies change.
 // Example: XEffect("console.log('Count changed:', count)")
-func XEffect(expression string) html.Global {
-	return html.ACustom("x-effect", expression)
+func XEffect[E Expression](expression E) html.Global {
+	return directive("x-effect", expression)
 }
 
 // XRef references DOM elements.
@@ -209,160 +216,162 @@ func XTeleport(selector string) html.Global {
 }
 
 // XIgnore tells Alpine to ignore a block of HTML.
+// Like XCloak, it renders with the value "true" so html.Render keeps it.
 func XIgnore() html.Global {
-	return html.ACustom("x-ignore", "")
+	return html.ACustom("x-ignore", "true")
 }
 
 // XId generates unique IDs for elements.
 // Example: XId("['input', 'label']")
-func XId(expression string) html.Global {
-	return html.ACustom("x-id", expression)
+func XId[E Expression](expression E) html.Global {
+	return directive("x-id", expression)
 }
 
 // Shorthand helpers using @ syntax
 
 // At is a shorthand for event listeners using @ syntax.
 // Example: At("click", "open = !open") produces @click="open = !open"
-func At(event, handler string) html.Global {
-	return html.ACustom("@"+event, handler)
+func At[E Expression](event string, handler E) html.Global {
+	return directive("@"+event, handler)
 }
 
 // AtClick is a shorthand for @click.
-func AtClick(handler string) html.Global {
-	return html.ACustom("@click", handler)
+func AtClick[E Expression](handler E) html.Global {
+	return directive("@click", handler)
 }
 
 // AtSubmit is a shorthand for @submit.
-func AtSubmit(handler string) html.Global {
-	return html.ACustom("@submit", handler)
+func AtSubmit[E Expression](handler E) html.Global {
+	return directive("@submit", handler)
 }
 
 // AtChange is a shorthand for @change.
-func AtChange(handler string) html.Global {
-	return html.ACustom("@change", handler)
+func AtChange[E Expression](handler E) html.Global {
+	return directive("@change", handler)
 }
 
 // AtInput is a shorthand for @input.
-func AtInput(handler string) html.Global {
-	return html.ACustom("@input", handler)
+func AtInput[E Expression](handler E) html.Global {
+	return directive("@input", handler)
 }
 
 // AtKeydown is a shorthand for @keydown.
-func AtKeydown(handler string) html.Global {
-	return html.ACustom("@keydown", handler)
+func AtKeydown[E Expression](handler E) html.Global {
+	return directive("@keydown", handler)
 }
 
 // AtKeyup is a shorthand for @keyup.
-func AtKeyup(handler string) html.Global {
-	return html.ACustom("@keyup", handler)
+func AtKeyup[E Expression](handler E) html.Global {
+	return directive("@keyup", handler)
 }
 
 // AtMouseenter is a shorthand for @mouseenter.
-func AtMouseenter(handler string) html.Global {
-	return html.ACustom("@mouseenter", handler)
+func AtMouseenter[E Expression](handler E) html.Global {
+	return directive("@mouseenter", handler)
 }
 
 // AtMouseleave is a shorthand for @mouseleave.
-func AtMouseleave(handler string) html.Global {
-	return html.ACustom("@mouseleave", handler)
+func AtMouseleave[E Expression](handler E) html.Global {
+	return directive("@mouseleave", handler)
 }
 
 // Colon is a shorthand for attribute binding using : syntax.
 // Example: Colon("class", "{ 'hidden': !open }") produces :class="{ 'hidden': !open }"
-func Colon(attribute, expression string) html.Global {
-	return html.ACustom(":"+attribute, expression)
+func Colon[E Expression](attribute string, expression E) html.Global {
+	return directive(":"+attribute, expression)
 }
 
 // ColonClass is a shorthand for :class.
-func ColonClass(expression string) html.Global {
-	return html.ACustom(":class", expression)
+func ColonClass[E Expression](expression E) html.Global {
+	return directive(":class", expression)
 }
 
 // ColonStyle is a shorthand for :style.
-func ColonStyle(expression string) html.Global {
-	return html.ACustom(":style", expression)
+func ColonStyle[E Expression](expression E) html.Global {
+	return directive(":style", expression)
 }
 
 // ColonDisabled is a shorthand for :disabled.
-func ColonDisabled(expression string) html.Global {
-	return html.ACustom(":disabled", expression)
+func ColonDisabled[E Expression](expression E) html.Global {
+	return directive(":disabled", expression)
 }
 
 // ColonValue is a shorthand for :value.
-func ColonValue(expression string) html.Global {
-	return html.ACustom(":value", expression)
+func ColonValue[E Expression](expression E) html.Global {
+	return directive(":value", expression)
 }
 
 // Event Modifiers (common ones as helper functions)
 
 // AtClickAway listens for clicks outside the element.
-func AtClickAway(handler string) html.Global {
-	return html.ACustom("@click.away", handler)
+func AtClickAway[E Expression](handler E) html.Global {
+	return directive("@click.away", handler)
 }
 
 // AtClickOutside listens for clicks outside the element (alias for away).
-func AtClickOutside(handler string) html.Global {
-	return html.ACustom("@click.outside", handler)
+func AtClickOutside[E Expression](handler E) html.Global {
+	return directive("@click.outside", handler)
 }
 
 // AtClickPrevent prevents default behavior.
-func AtClickPrevent(handler string) html.Global {
-	return html.ACustom("@click.prevent", handler)
+func AtClickPrevent[E Expression](handler E) html.Global {
+	return directive("@click.prevent", handler)
 }
 
 // AtClickStop stops event propagation.
-func AtClickStop(handler string) html.Global {
-	return html.ACustom("@click.stop", handler)
+func AtClickStop[E Expression](handler E) html.Global {
+	return directive("@click.stop", handler)
 }
 
 // AtSubmitPrevent prevents form submission.
-func AtSubmitPrevent(handler string) html.Global {
-	return html.ACustom("@submit.prevent", handler)
+func AtSubmitPrevent[E Expression](handler E) html.Global {
+	return directive("@submit.prevent", handler)
 }
 
 // AtKeydownEscape listens for escape key.
-func AtKeydownEscape(handler string) html.Global {
-	return html.ACustom("@keydown.escape", handler)
+func AtKeydownEscape[E Expression](handler E) html.Global {
+	return directive("@keydown.escape", handler)
 }
 
 // AtKeydownEnter listens for enter key.
-func AtKeydownEnter(handler string) html.Global {
-	return html.ACustom("@keydown.enter", handler)
+func AtKeydownEnter[E Expression](handler E) html.Global {
+	return directive("@keydown.enter", handler)
 }
 
 // AtKeydownWindow listens for keydown on window.
-func AtKeydownWindow(handler string) html.Global {
-	return html.ACustom("@keydown.window", handler)
+func AtKeydownWindow[E Expression](handler E) html.Global {
+	return directive("@keydown.window", handler)
 }
 
 // Model modifiers
 
 // XModelLazy updates the model on change instead of input.
-func XModelLazy(expression string) html.Global {
-	return html.ACustom("x-model.lazy", expression)
+func XModelLazy[E Expression](expression E) html.Global {
+	return directive("x-model.lazy", expression)
 }
 
 // XModelNumber automatically converts the value to a number.
-func XModelNumber(expression string) html.Global {
-	return html.ACustom("x-model.number", expression)
+func XModelNumber[E Expression](expression E) html.Global {
+	return directive("x-model.number", expression)
 }
 
 // XModelDebounce debounces the model update.
 // Example: XModelDebounce("searchQuery", "500ms")
-func XModelDebounce(expression, delay string) html.Global {
-	return html.ACustom("x-model.debounce."+delay, expression)
+func XModelDebounce[E Expression](expression E, delay string) html.Global {
+	return directive("x-model.debounce."+delay, expression)
 }
 
 // JavaScript returns the embedded Alpine.js JavaScript content.
 // This can be used to serve the Alpine.js library directly from your Go application
-// without requiring external CDN dependencies.
-//
-// Example usage:
+// without requiring external CDN dependencies. Options select another build,
+// such as CSPBuild or Dev; the result is nil if that build is not bundled.
 //
-//	http.HandleFunc("/js/alpine.min.js", func(w http.ResponseWriter, r *http.Request) {
-//	    w.Header().Set("Content-Type", "application/javascript")
-//	    w.Write(alpine.JavaScript())
-//	})
-func JavaScript() []byte {
-	return js.AlpineMinJS
+// To serve it over HTTP with caching and compression, use Handler.
+func JavaScript(opts ...Option) []byte {
+	a, _ := buildAsset(opts)
+	if a == nil {
+		return nil
+	}
+
+	return a.body
 }
@@ -3,6 +3,9 @@ package alpine
 import (
 	"strings"
 	"testing"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
 )
 
 func TestJavaScript(t *testing.T) {
@@ -38,3 +41,56 @@ func TestJavaScriptSize(t *testing.T) {
 		t.Error("JavaScript content seems too large for minified Alpine.js library")
 	}
 }
+
+func TestDirectivesAcceptExpr(t *testing.T) {
+	count := expr.Ident("count")
+
+	tests := map[string]html.Global{
+		` x-show="count &gt; 0"`:               XShow(count.Gt(expr.Value(0))),
+		` @click="count = count + 1"`:          AtClick(count.Set(count.Add(expr.Value(1)))),
+		` x-text="count"`:                      XText("count"),
+		` :title="` + "`${count} items`" + `"`: Colon("title", expr.Template(count, " items")),
+	}
+
+	for want, attr := range tests {
+		if got := renderAttrs(attr); got != "<div"+want+"></div>" {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestBuildersAcceptExpr(t *testing.T) {
+	open := expr.Ident("open")
+	toggle := open.Set(open.Not())
+
+	tests := map[string]html.Global{
+		` x-on:click.prevent="open = !open"`:                   On("click").Prevent().HandlerExpr(toggle),
+		` @click.prevent="open = !open"`:                       On("click").Prevent().AtExpr(toggle),
+		` x-intersect.once="open = !open"`:                     Intersect().Once().HandlerExpr(toggle),
+		` x-intersect:enter="open = !open"`:                    Intersect().EnterExpr(toggle),
+		` x-intersect:leave="open = !open"`:                    Intersect().LeaveExpr(toggle),
+		` x-trap.inert="open"`:                                 Trap().Inert().HandlerExpr(open),
+		` x-sort="open = !open"`:                               Sortable().HandlerExpr(toggle),
+		` x-anchor="open"`:                                     Anchor().HandlerExpr(open),
+		` x-bind:class="{active: open}"`:                       Classes().WhenExpr("active", open).XBindClass(),
+		` x-bind:style="{opacity: open}"`:                      Styles().BindExpr("opacity", open).XBindStyle(),
+		` x-bind="{&#39;@click&#39;: &#39;open = !open&#39;}"`: XBindObject(Directives().SetExpr("@click", toggle)),
+	}
+
+	for want, attr := range tests {
+		if got := renderAttrs(attr); got != "<div"+want+"></div>" {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestFlagDirectivesRender(t *testing.T) {
+	for want, attr := range map[string]html.Global{
+		`<div x-cloak="true"></div>`:  XCloak(),
+		`<div x-ignore="true"></div>`: XIgnore(),
+	} {
+		if got := renderAttrs(attr); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Package alpinetest provides assertions for testing Alpine components built
+// with the alpine package.
+package alpinetest
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/plainkit/alpine"
+	"github.com/plainkit/html"
+)
+
+// AssertPersists fails the test unless v, a DataObject or a component or
+// store built from one, persists exactly the given storage keys.
+//
+//	alpinetest.AssertPersists(t, prefsComponent, "_x_theme", "prefs-sidebar")
+func AssertPersists(t testing.TB, v any, keys ...string) {
+	t.Helper()
+
+	want := slices.Clone(keys)
+	sort.Strings(want)
+
+	if got := alpine.PersistedKeys(v); !slices.Equal(got, want) {
+		t.Errorf("persisted keys = %q, want %q", got, want)
+	}
+}
+
+// AssertCSP fails the test if n contains Alpine expressions the CSP build
+// cannot evaluate; see alpine.CheckCSP.
+//
+//	alpinetest.AssertCSP(t, TodoPage(todos))
+func AssertCSP(t testing.TB, n html.Component) {
+	t.Helper()
+
+	if err := alpine.CheckCSP(n); err != nil {
+		t.Errorf("page is not CSP-compatible:\n%v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package alpinetest
+
+import (
+	"testing"
+
+	"github.com/plainkit/alpine"
+	"github.com/plainkit/html"
+)
+
+// recorder is a testing.TB that records failures instead of reporting them.
+type recorder struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(string, ...any) { r.failed = true }
+
+func TestAssertPersists(t *testing.T) {
+	state := map[string]any{"open": false, "tab": "home"}
+	c := alpine.Component("tabs", alpine.Data(state).Persist("tab", alpine.PersistAs("tabs-active")).Persist("open"))
+
+	AssertPersists(t, c, "tabs-active", "_x_open")
+
+	rec := &recorder{TB: t}
+	AssertPersists(rec, c, "_x_tab")
+
+	if !rec.failed {
+		t.Error("AssertPersists did not fail on mismatched keys")
+	}
+}
+
+func TestAssertCSP(t *testing.T) {
+	AssertCSP(t, html.Div(alpine.XShow("open"), alpine.AtClick("toggle")))
+
+	rec := &recorder{TB: t}
+	AssertCSP(rec, html.Div(alpine.AtClick("open = !open")))
+
+	if !rec.failed {
+		t.Error("AssertCSP did not fail on an assignment")
+	}
+}
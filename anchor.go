@@ -0,0 +1,110 @@
+package alpine
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// Anchor plugin directives. They require PluginAnchor to be loaded (see Scripts).
+
+// Placement positions an anchored element relative to its reference.
+type Placement string
+
+// Placements supported by x-anchor.
+const (
+	PlacementTop         Placement = "top"
+	PlacementTopStart    Placement = "top-start"
+	PlacementTopEnd      Placement = "top-end"
+	PlacementRight       Placement = "right"
+	PlacementRightStart  Placement = "right-start"
+	PlacementRightEnd    Placement = "right-end"
+	PlacementBottom      Placement = "bottom"
+	PlacementBottomStart Placement = "bottom-start"
+	PlacementBottomEnd   Placement = "bottom-end"
+	PlacementLeft        Placement = "left"
+	PlacementLeftStart   Placement = "left-start"
+	PlacementLeftEnd     Placement = "left-end"
+)
+
+// Valid reports whether p is one of the Placement constants.
+func (p Placement) Valid() bool {
+	switch p {
+	case PlacementTop, PlacementTopStart, PlacementTopEnd,
+		PlacementRight, PlacementRightStart, PlacementRightEnd,
+		PlacementBottom, PlacementBottomStart, PlacementBottomEnd,
+		PlacementLeft, PlacementLeftStart, PlacementLeftEnd:
+		return true
+	}
+
+	return false
+}
+
+// XAnchor positions the element next to the referenced element.
+// Example: XAnchor("$refs.button")
+func XAnchor[E Expression](reference E) html.Global {
+	return directive("x-anchor", reference)
+}
+
+// Anchoring builds an x-anchor directive with modifiers.
+//
+//	alpine.Anchor().Placement(alpine.PlacementBottomStart).Offset(8).Handler("$refs.button")
+//	// x-anchor.bottom-start.offset.8="$refs.button"
+type Anchoring struct {
+	placement Placement
+	modifiers modifiers
+}
+
+// Anchor starts an x-anchor builder.
+func Anchor() Anchoring {
+	return Anchoring{}
+}
+
+// Placement sets where the element is placed (Alpine's default is bottom).
+func (a Anchoring) Placement(p Placement) Anchoring {
+	a.placement = p
+	return a
+}
+
+// Offset sets the gap between the element and its reference in pixels.
+func (a Anchoring) Offset(px int) Anchoring {
+	a.modifiers = a.modifiers.with("offset", strconv.Itoa(px))
+	return a
+}
+
+// NoStyle leaves positioning to the element, exposing coordinates via $anchor.
+func (a Anchoring) NoStyle() Anchoring {
+	a.modifiers = a.modifiers.with("no-style", "")
+	return a
+}
+
+// Err reports whether the placement is valid.
+func (a Anchoring) Err() error {
+	if a.placement != "" && !a.placement.Valid() {
+		return fmt.Errorf("alpine: invalid anchor placement %q", string(a.placement))
+	}
+
+	return nil
+}
+
+// Handler renders x-anchor with the configured modifiers.
+// It panics if the placement is invalid; use Err to check first.
+func (a Anchoring) Handler(reference string) html.Global {
+	if err := a.Err(); err != nil {
+		panic(err)
+	}
+
+	name := "x-anchor"
+	if a.placement != "" {
+		name += "." + string(a.placement)
+	}
+
+	return directive(name+a.modifiers.String(), reference)
+}
+
+// HandlerExpr is Handler for a reference built with package expr.
+func (a Anchoring) HandlerExpr(reference expr.Expr) html.Global {
+	return a.Handler(reference.String())
+}
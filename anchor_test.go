@@ -0,0 +1,28 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnchor(t *testing.T) {
+	out := renderAttrs(
+		XAnchor("$refs.trigger"),
+		Anchor().Placement(PlacementBottomStart).Offset(8).Handler("$refs.button"),
+		Anchor().NoStyle().Handler("$refs.menu"),
+	)
+
+	for _, want := range []string{
+		`x-anchor="$refs.trigger"`,
+		`x-anchor.bottom-start.offset.8="$refs.button"`,
+		`x-anchor.no-style="$refs.menu"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s in %s", want, out)
+		}
+	}
+
+	if Anchor().Placement("bottom-center").Err() == nil {
+		t.Error("expected invalid placement to be rejected")
+	}
+}
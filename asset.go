@@ -0,0 +1,90 @@
+package alpine
+
+import (
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/plainkit/alpine/js"
+	"github.com/plainkit/html"
+)
+
+// ScriptPrefix is the URL path Script expects AssetHandler to be mounted under.
+const ScriptPrefix = "/js"
+
+// fingerprintPattern matches an asset name of any release without its extension.
+var fingerprintPattern = regexp.MustCompile(`^alpine-[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?\.[0-9a-f]{16}$`)
+
+// Version is the release of the embedded Alpine.js, such as "3.15.0".
+// It is 3.15 by default; build with -tags alpine_v3_14 to embed 3.14
+// instead (see package js/v3_14).
+const Version = js.Version
+
+// assetName is the fingerprinted file name of a bundle.
+func assetName(a *asset, c buildConfig) string {
+	return "alpine-" + Version + "." + a.hash + assetExt(c)
+}
+
+// assetExt is the extension of the build c selects.
+func assetExt(c buildConfig) string {
+	if c.dev {
+		return ".js"
+	}
+
+	return ".min.js"
+}
+
+// isAssetName reports whether name is a fingerprinted name, from any
+// release, of the build c selects.
+func isAssetName(name string, c buildConfig) bool {
+	base, ok := strings.CutSuffix(name, assetExt(c))
+	return ok && fingerprintPattern.MatchString(base)
+}
+
+// AssetPath returns a fingerprinted URL path for the embedded bundle, such as
+// "/js/alpine-3.15.0.1a2b3c4d5e6f7a8b.min.js". The name changes whenever the
+// bundle does, which lets AssetHandler mark it immutable.
+// Options select another build, as for Handler.
+func AssetPath(prefix string, opts ...Option) string {
+	a, c := mustBuildAsset(opts)
+	return strings.TrimSuffix(prefix, "/") + "/" + assetName(a, c)
+}
+
+// AssetHandler serves the bundle under the name returned by AssetPath, like
+// Handler but with long-lived immutable caching. Requests for another
+// fingerprint of the same build get 410 Gone rather than the current bytes,
+// which would fail the integrity check of the page that asked for them;
+// any other name is a 404.
+// Only the last path element is inspected, so no StripPrefix is needed:
+//
+//	http.Handle("/js/", alpine.AssetHandler())
+//
+// The source map of a Dev build is served at the asset name plus ".map".
+func AssetHandler(opts ...Option) http.Handler {
+	a, c := mustBuildAsset(opts)
+	current := assetName(a, c)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := path.Base(r.URL.Path)
+
+		switch {
+		case name == current || name == current+".map":
+			a.serve(w, r, cacheImmutable)
+		case isAssetName(name, c):
+			w.Header().Set("Cache-Control", cacheRevalidate)
+			http.Error(w, http.StatusText(http.StatusGone), http.StatusGone)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// Script returns a deferred <script> tag loading the fingerprinted bundle
+// from ScriptPrefix, to pair with AssetHandler. It carries the bundle's
+// integrity hash (see IntegrityScript). Pass the same options as to
+// AssetHandler, e.g. Script(Dev()) during development.
+func Script(opts ...Option) html.Node {
+	a, _ := mustBuildAsset(opts)
+	return IntegrityScript(AssetPath(ScriptPrefix, opts...), a.sri())
+}
@@ -0,0 +1,59 @@
+package alpine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestAssetPath(t *testing.T) {
+	p := AssetPath("/static/")
+	if !regexp.MustCompile(`^/static/alpine-` + regexp.QuoteMeta(Version) + `\.[0-9a-f]{16}\.min\.js$`).MatchString(p) {
+		t.Errorf("AssetPath = %q", p)
+	}
+
+	out := html.Render(Script())
+	if !strings.Contains(out, ` src="`+AssetPath("/js")+`"`) || !strings.Contains(out, " defer") {
+		t.Errorf("Script() = %s", out)
+	}
+}
+
+func TestAssetHandler(t *testing.T) {
+	h := AssetHandler()
+
+	get := func(p string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, p, nil))
+
+		return rec
+	}
+
+	current := get(AssetPath("/js"))
+	if current.Code != http.StatusOK || current.Body.Len() != len(JavaScript()) {
+		t.Errorf("current: status %d, %d bytes", current.Code, current.Body.Len())
+	}
+
+	if got := current.Header().Get("Cache-Control"); got != "public, max-age=31536000, immutable" {
+		t.Errorf("current: Cache-Control = %q", got)
+	}
+
+	if rec := get("/js/alpine-3.14.1.0123456789abcdef.min.js"); rec.Code != http.StatusGone {
+		t.Errorf("stale: status %d", rec.Code)
+	}
+
+	for _, p := range []string{"/js/alpine.min.js", "/js/alpine-3.15.0.min.js", "/js/alpine-3.14.1.0123456789abcdef.js", "/js/other.js"} {
+		if rec := get(p); rec.Code != http.StatusNotFound {
+			t.Errorf("%s: status %d", p, rec.Code)
+		}
+	}
+}
+
+func TestVersion(t *testing.T) {
+	if !strings.Contains(string(JavaScript()), `version:"`+Version+`"`) {
+		t.Errorf("embedded bundle is not Alpine %s", Version)
+	}
+}
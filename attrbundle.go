@@ -0,0 +1,149 @@
+package alpine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/plainkit/html"
+)
+
+//go:generate go run ./internal/bundlegen
+
+// Bundle groups attributes that are always used together into one value
+// accepted wherever an html.Global is:
+//
+//	func Dropdown(open string) alpine.Bundle {
+//		return alpine.BundleOf(
+//			alpine.XShow(open),
+//			alpine.XTransitionEnter("transition ease-out duration-150"),
+//			alpine.AtClickOutside(open+" = false"),
+//			alpine.XCloak(),
+//		)
+//	}
+//
+//	html.Div(Dropdown("menuOpen"), alpine.XTrap("menuOpen"))
+//
+// When attributes conflict, the later one wins: With(XShow("b")) replaces
+// an XShow in the bundle. Shorthands count as their long form, so "@click"
+// replaces "x-on:click" and ":class" replaces "x-bind:class". In strict mode
+// conflicts are errors instead.
+type Bundle struct {
+	entries []bundleEntry
+	strict  bool
+	err     error
+}
+
+type bundleEntry struct {
+	attr  html.Global
+	names []string // normalized names of the custom attributes it sets
+}
+
+// BundleOf returns a Bundle of attrs, resolving conflicts as With does.
+func BundleOf(attrs ...html.Global) Bundle {
+	return Bundle{}.With(attrs...)
+}
+
+// Strict makes later conflicting attributes an error instead of an
+// override. Conflicts already resolved are not revisited.
+func (b Bundle) Strict() Bundle {
+	b.strict = true
+	return b
+}
+
+// With adds attrs after the bundle's own, replacing any they conflict with.
+func (b Bundle) With(attrs ...html.Global) Bundle {
+	entries := append([]bundleEntry(nil), b.entries...)
+
+	for _, attr := range attrs {
+		var ga html.GlobalAttrs
+		attr.Do(&ga)
+
+		names := make([]string, 0, len(ga.Custom))
+		for name := range ga.Custom {
+			names = append(names, normalizeAttr(name))
+		}
+
+		kept := entries[:0]
+
+		for _, e := range entries {
+			if conflict := overlap(e.names, names); conflict != "" {
+				if b.strict && b.err == nil {
+					b.err = fmt.Errorf("alpine: bundle sets %s more than once", conflict)
+				}
+
+				continue
+			}
+
+			kept = append(kept, e)
+		}
+
+		entries = append(kept, bundleEntry{attr: attr, names: names})
+	}
+
+	b.entries = entries
+
+	return b
+}
+
+// Merge adds the attributes of others in order, as With does. The result
+// is strict if b is, and carries the first error of any bundle.
+func (b Bundle) Merge(others ...Bundle) Bundle {
+	for _, o := range others {
+		if b.err == nil {
+			b.err = o.err
+		}
+
+		b = b.With(o.Attrs()...)
+	}
+
+	return b
+}
+
+// Err reports the first conflict found in strict mode.
+func (b Bundle) Err() error {
+	return b.err
+}
+
+// Attrs returns the resolved attributes in order.
+func (b Bundle) Attrs() []html.Global {
+	attrs := make([]html.Global, len(b.entries))
+	for i, e := range b.entries {
+		attrs[i] = e.attr
+	}
+
+	return attrs
+}
+
+// mustAttrs is Attrs for rendering, which panics if the bundle is invalid.
+func (b Bundle) mustAttrs() []html.Global {
+	if b.err != nil {
+		panic(b.err)
+	}
+
+	return b.Attrs()
+}
+
+// normalizeAttr expands the @ and : shorthands to x-on: and x-bind:.
+func normalizeAttr(name string) string {
+	switch {
+	case strings.HasPrefix(name, "@"):
+		return "x-on:" + name[1:]
+	case strings.HasPrefix(name, ":"):
+		return "x-bind:" + name[1:]
+	default:
+		return name
+	}
+}
+
+// overlap returns a name present in both lists, or "".
+func overlap(a, b []string) string {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return x
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,1199 @@
+// Code generated by internal/bundlegen; DO NOT EDIT.
+
+package alpine
+
+import "github.com/plainkit/html"
+
+func (b Bundle) Apply(a *html.SvgAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.Apply(a, kids)
+	}
+}
+
+func (b Bundle) ApplyA(a *html.AAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyA(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAbbr(a *html.AbbrAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAbbr(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAddress(a *html.AddressAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAddress(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAltGlyph(a *html.SvgAltGlyphAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAltGlyph(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAltGlyphDef(a *html.SvgAltGlyphDefAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAltGlyphDef(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAltGlyphItem(a *html.SvgAltGlyphItemAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAltGlyphItem(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAnimate(a *html.SvgAnimateAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAnimate(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAnimateColor(a *html.SvgAnimateColorAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAnimateColor(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAnimateMotion(a *html.SvgAnimateMotionAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAnimateMotion(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAnimateTransform(a *html.SvgAnimateTransformAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAnimateTransform(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAnimation(a *html.SvgAnimationAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAnimation(a, kids)
+	}
+}
+
+func (b Bundle) ApplyArea(a *html.AreaAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyArea(a, kids)
+	}
+}
+
+func (b Bundle) ApplyArticle(a *html.ArticleAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyArticle(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAside(a *html.AsideAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAside(a, kids)
+	}
+}
+
+func (b Bundle) ApplyAudio(a *html.AudioAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyAudio(a, kids)
+	}
+}
+
+func (b Bundle) ApplyB(a *html.BAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyB(a, kids)
+	}
+}
+
+func (b Bundle) ApplyBase(a *html.BaseAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyBase(a, kids)
+	}
+}
+
+func (b Bundle) ApplyBdi(a *html.BdiAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyBdi(a, kids)
+	}
+}
+
+func (b Bundle) ApplyBdo(a *html.BdoAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyBdo(a, kids)
+	}
+}
+
+func (b Bundle) ApplyBlockquote(a *html.BlockquoteAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyBlockquote(a, kids)
+	}
+}
+
+func (b Bundle) ApplyBody(a *html.BodyAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyBody(a, kids)
+	}
+}
+
+func (b Bundle) ApplyBr(a *html.BrAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyBr(a, kids)
+	}
+}
+
+func (b Bundle) ApplyButton(a *html.ButtonAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyButton(a, kids)
+	}
+}
+
+func (b Bundle) ApplyCanvas(a *html.CanvasAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyCanvas(a, kids)
+	}
+}
+
+func (b Bundle) ApplyCaption(a *html.CaptionAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyCaption(a, kids)
+	}
+}
+
+func (b Bundle) ApplyCircle(a *html.SvgCircleAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyCircle(a, kids)
+	}
+}
+
+func (b Bundle) ApplyCite(a *html.CiteAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyCite(a, kids)
+	}
+}
+
+func (b Bundle) ApplyClipPath(a *html.SvgClipPathAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyClipPath(a, kids)
+	}
+}
+
+func (b Bundle) ApplyCode(a *html.CodeAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyCode(a, kids)
+	}
+}
+
+func (b Bundle) ApplyCol(a *html.ColAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyCol(a, kids)
+	}
+}
+
+func (b Bundle) ApplyColgroup(a *html.ColgroupAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyColgroup(a, kids)
+	}
+}
+
+func (b Bundle) ApplyColorProfile(a *html.SvgColorProfileAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyColorProfile(a, kids)
+	}
+}
+
+func (b Bundle) ApplyCursor(a *html.SvgCursorAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyCursor(a, kids)
+	}
+}
+
+func (b Bundle) ApplyData(a *html.DataAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyData(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDatalist(a *html.DatalistAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDatalist(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDd(a *html.DdAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDd(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDefs(a *html.SvgDefsAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDefs(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDel(a *html.DelAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDel(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDesc(a *html.SvgDescAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDesc(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDetails(a *html.DetailsAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDetails(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDfn(a *html.DfnAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDfn(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDialog(a *html.DialogAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDialog(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDiscard(a *html.SvgDiscardAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDiscard(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDiv(a *html.DivAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDiv(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDl(a *html.DlAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDl(a, kids)
+	}
+}
+
+func (b Bundle) ApplyDt(a *html.DtAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyDt(a, kids)
+	}
+}
+
+func (b Bundle) ApplyEllipse(a *html.SvgEllipseAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyEllipse(a, kids)
+	}
+}
+
+func (b Bundle) ApplyEm(a *html.EmAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyEm(a, kids)
+	}
+}
+
+func (b Bundle) ApplyEmbed(a *html.EmbedAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyEmbed(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeBlend(a *html.SvgFeBlendAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeBlend(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeColorMatrix(a *html.SvgFeColorMatrixAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeColorMatrix(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeComponentTransfer(a *html.SvgFeComponentTransferAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeComponentTransfer(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeComposite(a *html.SvgFeCompositeAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeComposite(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeConvolveMatrix(a *html.SvgFeConvolveMatrixAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeConvolveMatrix(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeDiffuseLighting(a *html.SvgFeDiffuseLightingAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeDiffuseLighting(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeDisplacementMap(a *html.SvgFeDisplacementMapAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeDisplacementMap(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeDistantLight(a *html.SvgFeDistantLightAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeDistantLight(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeDropShadow(a *html.SvgFeDropShadowAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeDropShadow(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeFlood(a *html.SvgFeFloodAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeFlood(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeFuncA(a *html.SvgFeFuncAAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeFuncA(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeFuncB(a *html.SvgFeFuncBAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeFuncB(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeFuncG(a *html.SvgFeFuncGAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeFuncG(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeFuncR(a *html.SvgFeFuncRAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeFuncR(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeGaussianBlur(a *html.SvgFeGaussianBlurAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeGaussianBlur(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeImage(a *html.SvgFeImageAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeImage(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeMerge(a *html.SvgFeMergeAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeMerge(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeMergeNode(a *html.SvgFeMergeNodeAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeMergeNode(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeMorphology(a *html.SvgFeMorphologyAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeMorphology(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeOffset(a *html.SvgFeOffsetAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeOffset(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFePointLight(a *html.SvgFePointLightAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFePointLight(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeSpecularLighting(a *html.SvgFeSpecularLightingAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeSpecularLighting(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeSpotLight(a *html.SvgFeSpotLightAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeSpotLight(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeTile(a *html.SvgFeTileAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeTile(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFeTurbulence(a *html.SvgFeTurbulenceAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFeTurbulence(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFieldset(a *html.FieldsetAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFieldset(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFigcaption(a *html.FigcaptionAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFigcaption(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFigure(a *html.FigureAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFigure(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFilter(a *html.SvgFilterAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFilter(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFont(a *html.SvgFontAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFont(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFontFace(a *html.SvgFontFaceAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFontFace(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFontFaceFormat(a *html.SvgFontFaceFormatAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFontFaceFormat(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFontFaceName(a *html.SvgFontFaceNameAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFontFaceName(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFontFaceSrc(a *html.SvgFontFaceSrcAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFontFaceSrc(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFontFaceUri(a *html.SvgFontFaceUriAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFontFaceUri(a, kids)
+	}
+}
+
+func (b Bundle) ApplyFooter(a *html.FooterAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyFooter(a, kids)
+	}
+}
+
+func (b Bundle) ApplyForeignObject(a *html.SvgForeignObjectAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyForeignObject(a, kids)
+	}
+}
+
+func (b Bundle) ApplyForm(a *html.FormAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyForm(a, kids)
+	}
+}
+
+func (b Bundle) ApplyG(a *html.SvgGAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyG(a, kids)
+	}
+}
+
+func (b Bundle) ApplyGlyph(a *html.SvgGlyphAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyGlyph(a, kids)
+	}
+}
+
+func (b Bundle) ApplyGlyphRef(a *html.SvgGlyphRefAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyGlyphRef(a, kids)
+	}
+}
+
+func (b Bundle) ApplyH1(a *html.H1Attrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyH1(a, kids)
+	}
+}
+
+func (b Bundle) ApplyH2(a *html.H2Attrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyH2(a, kids)
+	}
+}
+
+func (b Bundle) ApplyH3(a *html.H3Attrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyH3(a, kids)
+	}
+}
+
+func (b Bundle) ApplyH4(a *html.H4Attrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyH4(a, kids)
+	}
+}
+
+func (b Bundle) ApplyH5(a *html.H5Attrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyH5(a, kids)
+	}
+}
+
+func (b Bundle) ApplyH6(a *html.H6Attrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyH6(a, kids)
+	}
+}
+
+func (b Bundle) ApplyHandler(a *html.SvgHandlerAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyHandler(a, kids)
+	}
+}
+
+func (b Bundle) ApplyHead(a *html.HeadAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyHead(a, kids)
+	}
+}
+
+func (b Bundle) ApplyHeader(a *html.HeaderAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyHeader(a, kids)
+	}
+}
+
+func (b Bundle) ApplyHgroup(a *html.HgroupAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyHgroup(a, kids)
+	}
+}
+
+func (b Bundle) ApplyHkern(a *html.SvgHkernAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyHkern(a, kids)
+	}
+}
+
+func (b Bundle) ApplyHr(a *html.HrAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyHr(a, kids)
+	}
+}
+
+func (b Bundle) ApplyHtml(a *html.HtmlAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyHtml(a, kids)
+	}
+}
+
+func (b Bundle) ApplyI(a *html.IAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyI(a, kids)
+	}
+}
+
+func (b Bundle) ApplyIframe(a *html.IframeAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyIframe(a, kids)
+	}
+}
+
+func (b Bundle) ApplyImage(a *html.SvgImageAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyImage(a, kids)
+	}
+}
+
+func (b Bundle) ApplyImg(a *html.ImgAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyImg(a, kids)
+	}
+}
+
+func (b Bundle) ApplyInput(a *html.InputAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyInput(a, kids)
+	}
+}
+
+func (b Bundle) ApplyIns(a *html.InsAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyIns(a, kids)
+	}
+}
+
+func (b Bundle) ApplyKbd(a *html.KbdAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyKbd(a, kids)
+	}
+}
+
+func (b Bundle) ApplyLabel(a *html.LabelAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyLabel(a, kids)
+	}
+}
+
+func (b Bundle) ApplyLegend(a *html.LegendAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyLegend(a, kids)
+	}
+}
+
+func (b Bundle) ApplyLi(a *html.LiAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyLi(a, kids)
+	}
+}
+
+func (b Bundle) ApplyLine(a *html.SvgLineAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyLine(a, kids)
+	}
+}
+
+func (b Bundle) ApplyLinearGradient(a *html.SvgLinearGradientAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyLinearGradient(a, kids)
+	}
+}
+
+func (b Bundle) ApplyLink(a *html.LinkAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyLink(a, kids)
+	}
+}
+
+func (b Bundle) ApplyListener(a *html.SvgListenerAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyListener(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMain(a *html.MainAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMain(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMap(a *html.MapAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMap(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMark(a *html.MarkAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMark(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMarker(a *html.SvgMarkerAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMarker(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMask(a *html.SvgMaskAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMask(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMath(a *html.MathAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMath(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMenu(a *html.MenuAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMenu(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMeta(a *html.MetaAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMeta(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMetadata(a *html.SvgMetadataAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMetadata(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMeter(a *html.MeterAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMeter(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMissingGlyph(a *html.SvgMissingGlyphAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMissingGlyph(a, kids)
+	}
+}
+
+func (b Bundle) ApplyMpath(a *html.SvgMpathAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyMpath(a, kids)
+	}
+}
+
+func (b Bundle) ApplyNav(a *html.NavAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyNav(a, kids)
+	}
+}
+
+func (b Bundle) ApplyNoscript(a *html.NoscriptAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyNoscript(a, kids)
+	}
+}
+
+func (b Bundle) ApplyObject(a *html.ObjectAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyObject(a, kids)
+	}
+}
+
+func (b Bundle) ApplyOl(a *html.OlAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyOl(a, kids)
+	}
+}
+
+func (b Bundle) ApplyOptgroup(a *html.OptgroupAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyOptgroup(a, kids)
+	}
+}
+
+func (b Bundle) ApplyOption(a *html.OptionAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyOption(a, kids)
+	}
+}
+
+func (b Bundle) ApplyOutput(a *html.OutputAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyOutput(a, kids)
+	}
+}
+
+func (b Bundle) ApplyP(a *html.PAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyP(a, kids)
+	}
+}
+
+func (b Bundle) ApplyPath(a *html.SvgPathAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyPath(a, kids)
+	}
+}
+
+func (b Bundle) ApplyPattern(a *html.SvgPatternAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyPattern(a, kids)
+	}
+}
+
+func (b Bundle) ApplyPicture(a *html.PictureAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyPicture(a, kids)
+	}
+}
+
+func (b Bundle) ApplyPolygon(a *html.SvgPolygonAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyPolygon(a, kids)
+	}
+}
+
+func (b Bundle) ApplyPolyline(a *html.SvgPolylineAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyPolyline(a, kids)
+	}
+}
+
+func (b Bundle) ApplyPre(a *html.PreAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyPre(a, kids)
+	}
+}
+
+func (b Bundle) ApplyPrefetch(a *html.SvgPrefetchAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyPrefetch(a, kids)
+	}
+}
+
+func (b Bundle) ApplyProgress(a *html.ProgressAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyProgress(a, kids)
+	}
+}
+
+func (b Bundle) ApplyQ(a *html.QAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyQ(a, kids)
+	}
+}
+
+func (b Bundle) ApplyRadialGradient(a *html.SvgRadialGradientAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyRadialGradient(a, kids)
+	}
+}
+
+func (b Bundle) ApplyRect(a *html.SvgRectAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyRect(a, kids)
+	}
+}
+
+func (b Bundle) ApplyRp(a *html.RpAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyRp(a, kids)
+	}
+}
+
+func (b Bundle) ApplyRt(a *html.RtAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyRt(a, kids)
+	}
+}
+
+func (b Bundle) ApplyRuby(a *html.RubyAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyRuby(a, kids)
+	}
+}
+
+func (b Bundle) ApplyS(a *html.SAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyS(a, kids)
+	}
+}
+
+func (b Bundle) ApplySamp(a *html.SampAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySamp(a, kids)
+	}
+}
+
+func (b Bundle) ApplyScript(a *html.ScriptAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyScript(a, kids)
+	}
+}
+
+func (b Bundle) ApplySearch(a *html.SearchAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySearch(a, kids)
+	}
+}
+
+func (b Bundle) ApplySection(a *html.SectionAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySection(a, kids)
+	}
+}
+
+func (b Bundle) ApplySelect(a *html.SelectAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySelect(a, kids)
+	}
+}
+
+func (b Bundle) ApplySelectedcontent(a *html.SelectedcontentAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySelectedcontent(a, kids)
+	}
+}
+
+func (b Bundle) ApplySet(a *html.SvgSetAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySet(a, kids)
+	}
+}
+
+func (b Bundle) ApplySlot(a *html.SlotAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySlot(a, kids)
+	}
+}
+
+func (b Bundle) ApplySmall(a *html.SmallAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySmall(a, kids)
+	}
+}
+
+func (b Bundle) ApplySolidColor(a *html.SvgSolidColorAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySolidColor(a, kids)
+	}
+}
+
+func (b Bundle) ApplySource(a *html.SourceAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySource(a, kids)
+	}
+}
+
+func (b Bundle) ApplySpan(a *html.SpanAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySpan(a, kids)
+	}
+}
+
+func (b Bundle) ApplyStop(a *html.SvgStopAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyStop(a, kids)
+	}
+}
+
+func (b Bundle) ApplyStrong(a *html.StrongAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyStrong(a, kids)
+	}
+}
+
+func (b Bundle) ApplyStyle(a *html.StyleAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyStyle(a, kids)
+	}
+}
+
+func (b Bundle) ApplySub(a *html.SubAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySub(a, kids)
+	}
+}
+
+func (b Bundle) ApplySummary(a *html.SummaryAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySummary(a, kids)
+	}
+}
+
+func (b Bundle) ApplySup(a *html.SupAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySup(a, kids)
+	}
+}
+
+func (b Bundle) ApplySwitch(a *html.SvgSwitchAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySwitch(a, kids)
+	}
+}
+
+func (b Bundle) ApplySymbol(a *html.SvgSymbolAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplySymbol(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTable(a *html.TableAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTable(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTbody(a *html.TbodyAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTbody(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTbreak(a *html.SvgTbreakAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTbreak(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTd(a *html.TdAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTd(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTemplate(a *html.TemplateAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTemplate(a, kids)
+	}
+}
+
+func (b Bundle) ApplyText(a *html.SvgTextAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyText(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTextPath(a *html.SvgTextPathAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTextPath(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTextarea(a *html.TextareaAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTextarea(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTfoot(a *html.TfootAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTfoot(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTh(a *html.ThAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTh(a, kids)
+	}
+}
+
+func (b Bundle) ApplyThead(a *html.TheadAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyThead(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTime(a *html.TimeAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTime(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTitle(a *html.TitleAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTitle(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTr(a *html.TrAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTr(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTrack(a *html.TrackAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTrack(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTref(a *html.SvgTrefAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTref(a, kids)
+	}
+}
+
+func (b Bundle) ApplyTspan(a *html.SvgTspanAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyTspan(a, kids)
+	}
+}
+
+func (b Bundle) ApplyU(a *html.UAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyU(a, kids)
+	}
+}
+
+func (b Bundle) ApplyUl(a *html.UlAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyUl(a, kids)
+	}
+}
+
+func (b Bundle) ApplyUnknown(a *html.SvgUnknownAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyUnknown(a, kids)
+	}
+}
+
+func (b Bundle) ApplyUse(a *html.SvgUseAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyUse(a, kids)
+	}
+}
+
+func (b Bundle) ApplyVar(a *html.VarAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyVar(a, kids)
+	}
+}
+
+func (b Bundle) ApplyVideo(a *html.VideoAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyVideo(a, kids)
+	}
+}
+
+func (b Bundle) ApplyView(a *html.SvgViewAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyView(a, kids)
+	}
+}
+
+func (b Bundle) ApplyVkern(a *html.SvgVkernAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyVkern(a, kids)
+	}
+}
+
+func (b Bundle) ApplyWbr(a *html.WbrAttrs, kids *[]html.Component) {
+	for _, g := range b.mustAttrs() {
+		g.ApplyWbr(a, kids)
+	}
+}
@@ -0,0 +1,76 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func dropdown(open string) Bundle {
+	return BundleOf(XShow(open), XTransitionEnter("ease-out"), AtClickOutside(open+" = false"), XCloak())
+}
+
+func TestBundleRenders(t *testing.T) {
+	out := html.Render(html.Div(dropdown("menu"), html.AId("m")))
+
+	for _, want := range []string{`x-show="menu"`, `x-transition:enter="ease-out"`, `@click.outside="menu = false"`, `x-cloak="true"`, `id="m"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s in %s", want, out)
+		}
+	}
+
+	out = html.Render(html.Button(BundleOf(AtClick("go()")), html.Text("Go")))
+	if !strings.Contains(out, `<button @click="go()"`) {
+		t.Errorf("button: %s", out)
+	}
+}
+
+func TestBundleOverrides(t *testing.T) {
+	b := dropdown("a").With(XShow("b"), XOn("click.outside", "close()"))
+
+	var ga html.GlobalAttrs
+	for _, g := range b.Attrs() {
+		g.Do(&ga)
+	}
+
+	want := map[string]string{"x-show": "b", "x-transition:enter": "ease-out", "x-on:click.outside": "close()", "x-cloak": "true"}
+	if len(ga.Custom) != len(want) {
+		t.Errorf("attrs = %v", ga.Custom)
+	}
+
+	for k, v := range want {
+		if got, ok := ga.Custom[k]; !ok || got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+
+	merged := BundleOf(ColonClass("a")).Merge(BundleOf(XBindClass("b"), XText("t")))
+	if attrs := merged.Attrs(); len(attrs) != 2 || customAttrs(attrs[0])["x-bind:class"] != "b" {
+		t.Errorf("Merge = %v", attrs)
+	}
+}
+
+func TestBundleStrict(t *testing.T) {
+	b := dropdown("a").Strict().With(XText("label"))
+	if err := b.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	b = b.With(XShow("b"))
+	if b.Err() == nil {
+		t.Fatal("expected conflict error")
+	}
+
+	if BundleOf().Merge(b).Err() == nil {
+		t.Error("Merge dropped the error")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("rendering an invalid bundle did not panic")
+		}
+	}()
+
+	html.Render(html.Div(b))
+}
@@ -0,0 +1,152 @@
+package alpine
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// DirectiveSet is a group of attributes and directives applied together
+// through x-bind, such as the trigger or panel of a dropdown. Bind it inline
+// with XBindObject, or register it under a name with Bind.
+//
+//	trigger := alpine.Directives().
+//		Set("type", "button").
+//		Set("@click", "open = !open").
+//		Set(":aria-expanded", "open")
+type DirectiveSet struct {
+	entries []directiveEntry
+	err     error
+}
+
+type directiveEntry struct {
+	name  string
+	value string
+}
+
+var attributeNamePattern = regexp.MustCompile(`^[@:]?[A-Za-z_][A-Za-z0-9_:.-]*$`)
+
+// Directives starts an empty DirectiveSet.
+func Directives() DirectiveSet {
+	return DirectiveSet{}
+}
+
+// Set adds an attribute, written as it would be on the element: a directive
+// such as "x-show", "@click.outside" or ":disabled" takes an expression, any
+// other attribute a literal value. Setting a name again replaces its value.
+func (d DirectiveSet) Set(name, value string) DirectiveSet {
+	if !attributeNamePattern.MatchString(name) {
+		d.err = fmt.Errorf("alpine: %q is not a valid attribute name", name)
+		return d
+	}
+
+	d.entries = append(d.entries[:len(d.entries):len(d.entries)], directiveEntry{name: name, value: value})
+
+	return d
+}
+
+// SetExpr is Set for a directive whose expression is built with package expr.
+func (d DirectiveSet) SetExpr(name string, expression expr.Expr) DirectiveSet {
+	return d.Set(name, expression.String())
+}
+
+// Err reports the first invalid attribute name.
+func (d DirectiveSet) Err() error {
+	return d.err
+}
+
+// Object returns the JavaScript object literal read by x-bind, with names
+// sorted and every value passed as a string, e.g.
+// "{'@click': 'open = !open', type: 'button'}".
+//
+// Alpine binds a static attribute by evaluating its value wrapped in double
+// quotes, so " and \ in static values are escaped for that second pass.
+func (d DirectiveSet) Object() string {
+	values := map[string]string{}
+	for _, e := range d.entries {
+		values[e.name] = e.value
+	}
+
+	parts := make([]string, 0, len(values))
+	for _, name := range sortedNames(values) {
+		key := name
+		if !identPattern.MatchString(key) {
+			key = singleQuote(key)
+		}
+
+		value := values[name]
+		if isStaticAttribute(name) {
+			value = doubleQuoteEscaper.Replace(value)
+		}
+
+		parts = append(parts, key+": "+singleQuote(value))
+	}
+
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// isStaticAttribute reports whether name is a plain attribute rather than
+// an Alpine directive or shorthand.
+func isStaticAttribute(name string) bool {
+	return !strings.HasPrefix(name, "x-") && !strings.HasPrefix(name, "@") && !strings.HasPrefix(name, ":")
+}
+
+// doubleQuoteEscaper escapes a value for the "${value}" expression Alpine
+// builds for static attributes in an x-bind object.
+var doubleQuoteEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\u2028", `\u2028`,
+	"\u2029", `\u2029`,
+)
+
+// XBindObject binds every attribute in d to the element:
+// x-bind="{'@click': 'open = !open', ...}".
+// It panics if d is invalid; use Err to check first.
+func XBindObject(d DirectiveSet) html.Global {
+	if d.err != nil {
+		panic(d.err)
+	}
+
+	return directive("x-bind", d.Object())
+}
+
+// BindDef is a DirectiveSet registered with Alpine.bind, so elements can
+// reference it by name instead of repeating the object.
+type BindDef struct {
+	name string
+	set  DirectiveSet
+}
+
+// Bind defines a named directive set. Add it to a Registry and apply it to
+// elements with XBind.
+//
+//	trigger := alpine.Bind("dropdownTrigger", alpine.Directives().Set("@click", "open = !open"))
+//	reg.Register(trigger)
+//	html.Button(trigger.XBind(), ...)
+func Bind(name string, set DirectiveSet) BindDef {
+	return BindDef{name: name, set: set}
+}
+
+// Name returns the registered name.
+func (b BindDef) Name() string {
+	return b.name
+}
+
+// XBind points an element at the registered set: x-bind="dropdownTrigger".
+func (b BindDef) XBind() html.Global {
+	return directive("x-bind", b.name)
+}
+
+func (b BindDef) register(r *Registry) error {
+	if err := b.set.Err(); err != nil {
+		return err
+	}
+
+	return r.addBind(b.name, "() => ("+b.set.Object()+")")
+}
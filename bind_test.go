@@ -0,0 +1,96 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestDirectiveSet(t *testing.T) {
+	d := Directives().
+		Set("type", "button").
+		Set("@click.outside", "open = false").
+		Set(":aria-expanded", "open").
+		Set("x-text", "label").
+		Set("type", "submit")
+
+	want := `{':aria-expanded': 'open', '@click.outside': 'open = false', type: 'submit', 'x-text': 'label'}`
+	if got := d.Object(); got != want {
+		t.Errorf("Object() = %s, want %s", got, want)
+	}
+
+	if got := customAttrs(XBindObject(d))["x-bind"]; got != want {
+		t.Errorf("x-bind = %s", got)
+	}
+
+	if got := Directives().Set("x-text", `it's "quoted"`).Object(); got != `{'x-text': 'it\'s "quoted"'}` {
+		t.Errorf("escaping: %s", got)
+	}
+
+	// Alpine evaluates static values as "${value}", so they are escaped twice.
+	if got := Directives().Set("title", `say "hi" \ bye`).Object(); got != `{title: 'say \\"hi\\" \\\\ bye'}` {
+		t.Errorf("static escaping: %s", got)
+	}
+
+	bad := Directives().Set("on click", "x")
+	if bad.Err() == nil {
+		t.Error("expected error for invalid name")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("XBindObject did not panic")
+		}
+	}()
+
+	XBindObject(bad)
+}
+
+func TestRegistryBinds(t *testing.T) {
+	trigger := Bind("trigger", Directives().Set("@click", "open = !open"))
+
+	reg := NewRegistry()
+	if err := reg.Register(Component("dropdown", struct{}{}), trigger, Store("theme", "dark")); err != nil {
+		t.Fatal(err)
+	}
+
+	js, err := reg.JS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `Alpine.store("theme", "dark");
+Alpine.bind("trigger", () => ({'@click': 'open = !open'}));
+Alpine.data("dropdown"`
+	if !strings.Contains(js, want) {
+		t.Errorf("JS() = %s", js)
+	}
+
+	if got := customAttrs(trigger.XBind())["x-bind"]; got != "trigger" {
+		t.Errorf("XBind() = %q", got)
+	}
+
+	for _, b := range []BindDef{trigger, Bind("bad-name", Directives()), Bind("other", Directives().Set("", "x"))} {
+		if err := reg.Register(b); err == nil {
+			t.Errorf("Register(%s) succeeded", b.Name())
+		}
+	}
+}
+
+func TestRegistryBindEscapesScript(t *testing.T) {
+	reg := NewRegistry()
+	if err := reg.Register(Bind("tip", Directives().Set("title", "</script><script>alert(1)</script>"))); err != nil {
+		t.Fatal(err)
+	}
+
+	script, err := reg.Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := html.Render(script)
+	if strings.Count(out, "</script>") != 1 || !strings.Contains(out, `'\u003c/script\u003e\u003cscript\u003ealert(1)`) {
+		t.Errorf("Script() = %s", out)
+	}
+}
@@ -0,0 +1,104 @@
+package alpine
+
+import (
+	"sync"
+
+	"github.com/plainkit/alpine/js"
+)
+
+// Option selects a variant of the embedded build for JavaScript, Handler,
+// AssetPath, AssetHandler and Script.
+type Option func(*buildConfig)
+
+type buildConfig struct {
+	csp bool
+	dev bool
+}
+
+// CSPBuild selects the @alpinejs/csp build, which evaluates expressions
+// without eval and so runs under a Content-Security-Policy lacking
+// 'unsafe-eval'. Check pages with CheckCSP for expressions it cannot run.
+func CSPBuild() Option {
+	return func(c *buildConfig) { c.csp = true }
+}
+
+// Dev selects the unminified build for debugging. If a source map was
+// vendored next to it, responses carry a SourceMap header and the map is
+// served at the script's URL plus ".map". It combines with CSPBuild.
+func Dev() Option {
+	return func(c *buildConfig) { c.dev = true }
+}
+
+// fileName is the name of the selected build in the release package's bundles directory.
+func (c buildConfig) fileName() string {
+	name := "alpine"
+	if c.csp {
+		name += "-csp"
+	}
+
+	if c.dev {
+		return name + ".js"
+	}
+
+	return name + ".min.js"
+}
+
+// buildAssets holds the assets loaded from the bundles directory, by file name.
+// Builds that are not vendored map to nil.
+var buildAssets sync.Map
+
+func loadBuild(name string) *asset {
+	if a, ok := buildAssets.Load(name); ok {
+		return a.(*asset)
+	}
+
+	var a *asset
+	if body := js.Bundle(name); body != nil {
+		a = newAsset(body, nil)
+		a.sourceMap = js.Bundle(name + ".map")
+	}
+
+	actual, _ := buildAssets.LoadOrStore(name, a)
+
+	return actual.(*asset)
+}
+
+// String describes the build for error messages.
+func (c buildConfig) String() string {
+	switch {
+	case c.csp && c.dev:
+		return "unminified CSP build"
+	case c.csp:
+		return "CSP build"
+	case c.dev:
+		return "unminified build"
+	default:
+		return "standard build"
+	}
+}
+
+// buildAsset returns the asset selected by opts with its configuration.
+// The asset is nil if that build is not vendored.
+func buildAsset(opts []Option) (*asset, buildConfig) {
+	var c buildConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	if !c.csp && !c.dev {
+		return coreAsset, c
+	}
+
+	return loadBuild(c.fileName()), c
+}
+
+// mustBuildAsset is buildAsset for helpers that panic when the selected
+// build is missing.
+func mustBuildAsset(opts []Option) (*asset, buildConfig) {
+	a, c := buildAsset(opts)
+	if a == nil {
+		panic("alpine: the " + c.String() + " is not vendored; run go generate ./js")
+	}
+
+	return a, c
+}
@@ -0,0 +1,105 @@
+package alpine
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestBuildFileNames(t *testing.T) {
+	tests := map[string][]Option{
+		"alpine.min.js":     nil,
+		"alpine-csp.min.js": {CSPBuild()},
+		"alpine.js":         {Dev()},
+		"alpine-csp.js":     {Dev(), CSPBuild()},
+	}
+
+	for want, opts := range tests {
+		var c buildConfig
+		for _, opt := range opts {
+			opt(&c)
+		}
+
+		if got := c.fileName(); got != want {
+			t.Errorf("fileName() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestDevBuild(t *testing.T) {
+	body := JavaScript(Dev())
+	if body == nil {
+		t.Fatal("alpine.js is not vendored; run go generate ./js")
+	}
+
+	if len(body) <= len(JavaScript()) {
+		t.Error("unminified build is not larger than the minified build")
+	}
+
+	if !strings.Contains(string(body), Version) {
+		t.Errorf("unminified build is not Alpine %s", Version)
+	}
+
+	if csp := JavaScript(Dev(), CSPBuild()); csp == nil || bytes.Equal(csp, body) {
+		t.Error("alpine-csp.js is not vendored or is identical to alpine.js")
+	}
+
+	rec := httptest.NewRecorder()
+	Handler(Dev()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/alpine.js", nil))
+
+	if rec.Body.String() != string(body) {
+		t.Error("Handler(Dev()) does not serve the unminified build")
+	}
+
+	if out := html.Render(Script(Dev())); !strings.Contains(out, ".js\"") || strings.Contains(out, ".min.js") {
+		t.Errorf("Script(Dev()) = %s", out)
+	}
+}
+
+func TestSourceMap(t *testing.T) {
+	a := newAsset([]byte("console.log(1)"), nil)
+	a.sourceMap = []byte(`{"version":3}`)
+
+	rec := httptest.NewRecorder()
+	a.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/js/alpine.js", nil))
+
+	if got := rec.Header().Get("SourceMap"); got != "alpine.js.map" {
+		t.Errorf("SourceMap = %q", got)
+	}
+
+	rec = httptest.NewRecorder()
+	a.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/js/alpine.js.map", nil))
+
+	if rec.Code != http.StatusOK || rec.Body.String() != `{"version":3}` {
+		t.Errorf("map: status %d, body %q", rec.Code, rec.Body.String())
+	}
+
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "application/json") {
+		t.Errorf("map Content-Type = %q", rec.Header().Get("Content-Type"))
+	}
+
+	rec = serve(Handler(), http.MethodGet, nil)
+	if rec.Header().Get("SourceMap") != "" {
+		t.Error("standard build advertises a source map")
+	}
+
+	rec = httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/js/alpine.min.js.map", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("missing map: status %d", rec.Code)
+	}
+}
+
+func TestAssetNameDev(t *testing.T) {
+	a := newAsset([]byte("x"), nil)
+	name := assetName(a, buildConfig{dev: true})
+
+	if !strings.HasSuffix(name, "."+a.hash+".js") || !isAssetName(name, buildConfig{dev: true}) || isAssetName(name, buildConfig{}) {
+		t.Errorf("assetName = %q", name)
+	}
+}
@@ -0,0 +1,200 @@
+package alpine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// ClassMap builds the object bound by :class, mapping class lists to the
+// expressions that toggle them.
+//
+//	alpine.Classes("p-2 text-sm").When("line-through text-gray-400", "todo.completed").XBindClass()
+//	// x-bind:class="{'line-through text-gray-400': todo.completed}"
+//
+// Keys are rendered in sorted order and quoted unless they are plain
+// identifiers, so class names such as "md:w-1/2" need no escaping.
+type ClassMap struct {
+	static  []string
+	entries []classEntry
+	merge   bool
+	err     error
+}
+
+type classEntry struct {
+	classes   string
+	condition string
+}
+
+// Classes starts a ClassMap. The static classes are always applied; render
+// them with Class alongside the binding.
+func Classes(static ...string) ClassMap {
+	return ClassMap{static: static}
+}
+
+// When applies classes while condition is truthy. Calling it again with the
+// same classes ors the conditions together. Conditions other than a plain or
+// negated property path are parenthesised, so "a, b" renders as
+// {hidden: (a, b)}.
+func (c ClassMap) When(classes, condition string) ClassMap {
+	classes = strings.Join(strings.Fields(classes), " ")
+
+	switch {
+	case classes == "":
+		c.err = errors.New("alpine: class map entry has no classes")
+		return c
+	case strings.TrimSpace(condition) == "":
+		c.err = fmt.Errorf("alpine: class map entry %q has no condition", classes)
+		return c
+	}
+
+	c.entries = append(c.entries[:len(c.entries):len(c.entries)], classEntry{classes: classes, condition: group(condition)})
+
+	return c
+}
+
+// WhenExpr is When for a condition built with package expr.
+func (c ClassMap) WhenExpr(classes string, condition expr.Expr) ClassMap {
+	return c.When(classes, condition.String())
+}
+
+// Merge resolves conflicts between static and conditional classes with
+// tailwind-merge (see html.ClassMerge). Static classes that a conditional
+// entry overrides, such as "p-2" against "p-4", are only applied while no
+// such entry is active, so the two never end up on the element together.
+func (c ClassMap) Merge() ClassMap {
+	c.merge = true
+	return c
+}
+
+// Err reports the first invalid entry.
+func (c ClassMap) Err() error {
+	return c.err
+}
+
+// Class returns the class attribute holding the static classes, merged
+// with html.ClassMerge when Merge is set. Classes moved into the binding by
+// Merge are left out.
+func (c ClassMap) Class() html.Global {
+	static, _ := c.resolve()
+	return html.AClass(static)
+}
+
+// Object returns the JavaScript object literal, e.g. "{hidden: !open}".
+func (c ClassMap) Object() string {
+	_, entries := c.resolve()
+
+	parts := make([]string, 0, len(entries))
+	for _, classes := range sortedNames(entries) {
+		parts = append(parts, classKey(classes)+": "+entries[classes])
+	}
+
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// XBindClass renders the map as x-bind:class.
+// It panics if the map is invalid; use Err to check first.
+func (c ClassMap) XBindClass() html.Global {
+	if c.err != nil {
+		panic(c.err)
+	}
+
+	return XBindClass(c.Object())
+}
+
+// ColonClass renders the map as :class.
+// It panics if the map is invalid; use Err to check first.
+func (c ClassMap) ColonClass() html.Global {
+	if c.err != nil {
+		panic(c.err)
+	}
+
+	return ColonClass(c.Object())
+}
+
+// resolve returns the static class list and the conditions keyed by class
+// list, applying Merge.
+func (c ClassMap) resolve() (string, map[string]string) {
+	conditions := map[string][]string{}
+	for _, e := range c.entries {
+		conditions[e.classes] = append(conditions[e.classes], e.condition)
+	}
+
+	static := strings.Join(strings.Fields(strings.Join(c.static, " ")), " ")
+
+	if c.merge && static != "" {
+		static = html.ClassMerge(static)
+
+		var kept []string
+
+		for _, class := range strings.Fields(static) {
+			var overriding []string
+
+			for _, e := range c.entries {
+				if overrides(e.classes, class) {
+					overriding = append(overriding, e.condition)
+				}
+			}
+
+			if overriding == nil {
+				kept = append(kept, class)
+				continue
+			}
+
+			conditions[class] = append(conditions[class], "!("+strings.Join(overriding, " || ")+")")
+		}
+
+		static = strings.Join(kept, " ")
+	}
+
+	entries := make(map[string]string, len(conditions))
+	for classes, conds := range conditions {
+		if len(conds) == 1 {
+			entries[classes] = conds[0]
+			continue
+		}
+
+		entries[classes] = strings.Join(conds, " || ")
+	}
+
+	return static, entries
+}
+
+// plainCondition matches a property path, optionally negated, which needs
+// no parentheses next to || or inside an object literal.
+var plainCondition = regexp.MustCompile(`^!?[A-Za-z_$][A-Za-z0-9_$]*(\.[A-Za-z_$][A-Za-z0-9_$]*)*$`)
+
+// group parenthesises condition unless it is a plain property path.
+func group(condition string) string {
+	condition = strings.TrimSpace(condition)
+	if plainCondition.MatchString(condition) {
+		return condition
+	}
+
+	return "(" + condition + ")"
+}
+
+// overrides reports whether tailwind-merge drops class in favour of classes.
+func overrides(classes, class string) bool {
+	for _, kept := range strings.Fields(html.ClassMerge(class, classes)) {
+		if kept == class {
+			return false
+		}
+	}
+
+	return true
+}
+
+// classKey renders a class list as an object key, quoting it unless it is
+// a plain identifier.
+func classKey(classes string) string {
+	if identPattern.MatchString(classes) {
+		return classes
+	}
+
+	return singleQuote(classes)
+}
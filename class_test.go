@@ -0,0 +1,73 @@
+package alpine
+
+import (
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestClassMap(t *testing.T) {
+	tests := map[string]ClassMap{
+		"{}":                       Classes("p-2"),
+		"{hidden: !open}":          Classes().When("hidden", "!open"),
+		"{'md:w-1/2': wide}":       Classes().When("md:w-1/2", "wide"),
+		"{'a b': x, hidden: y}":    Classes().When("hidden", "y").When("  a   b ", "x"),
+		"{hidden: a || b}":         Classes().When("hidden", "a").When("hidden", "b"),
+		"{hidden: (a, b)}":         Classes().When("hidden", "a, b"),
+		"{hidden: (a || b) || !c}": Classes().When("hidden", "a || b").When("hidden", "!c"),
+		"{hidden: (x > 1)}":        Classes().When("hidden", " x > 1 "),
+		`{'it\'s': x}`:             Classes().When("it's", "x"),
+	}
+
+	for want, c := range tests {
+		if got := c.Object(); got != want {
+			t.Errorf("Object() = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestClassMapMerge(t *testing.T) {
+	c := Classes("p-2 text-sm", "p-3").When("p-4", "big").When("font-bold", "active").Merge()
+
+	if got := classAttr(c.Class()); got != "text-sm" {
+		t.Errorf("Class() = %q", got)
+	}
+
+	if got := customAttrs(c.ColonClass())[":class"]; got != "{'font-bold': active, 'p-3': !(big), 'p-4': big}" {
+		t.Errorf(":class = %s", got)
+	}
+
+	plain := Classes("p-2", "p-3").When("p-4", "big")
+	if got := classAttr(plain.Class()); got != "p-2 p-3" {
+		t.Errorf("Class() without Merge = %q", got)
+	}
+
+	if got := customAttrs(plain.XBindClass())["x-bind:class"]; got != "{'p-4': big}" {
+		t.Errorf("x-bind:class = %s", got)
+	}
+}
+
+func classAttr(g html.Global) string {
+	var ga html.GlobalAttrs
+	g.Do(&ga)
+
+	return ga.Class
+}
+
+func TestClassMapErr(t *testing.T) {
+	for _, c := range []ClassMap{Classes().When(" ", "x"), Classes().When("hidden", "")} {
+		if c.Err() == nil {
+			t.Errorf("expected error for %+v", c)
+		}
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("XBindClass did not panic")
+				}
+			}()
+
+			c.XBindClass()
+		}()
+	}
+}
@@ -0,0 +1,72 @@
+package alpine
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/plainkit/html"
+)
+
+// Collapse plugin directives. They require PluginCollapse to be loaded (see Scripts).
+
+// XCollapse animates the height of an element shown or hidden with x-show.
+// The directive renders as x-collapse="true" because html.Render drops
+// attributes with empty values; Alpine ignores the value.
+func XCollapse() html.Global {
+	return html.ACustom("x-collapse", "true")
+}
+
+// Collapsing builds an x-collapse directive with modifiers.
+//
+//	alpine.Collapse().Duration(400 * time.Millisecond).Min(48).XCollapse()
+//	// x-collapse.duration.400ms.min.48px="true"
+type Collapsing struct {
+	modifiers modifiers
+	err       error
+}
+
+// Collapse starts an x-collapse builder.
+func Collapse() Collapsing {
+	return Collapsing{}
+}
+
+// Duration sets the animation length (Alpine's default is 250ms). It must
+// be at least 1ms, as the plugin counts whole milliseconds.
+func (c Collapsing) Duration(d time.Duration) Collapsing {
+	if d < time.Millisecond {
+		c.err = fmt.Errorf("alpine: collapse duration must be at least 1ms, got %s", d)
+		return c
+	}
+
+	c.modifiers = c.modifiers.with("duration", strconv.FormatInt(d.Milliseconds(), 10)+"ms")
+
+	return c
+}
+
+// Min sets the collapsed height in pixels instead of collapsing fully.
+func (c Collapsing) Min(px int) Collapsing {
+	if px < 0 {
+		c.err = fmt.Errorf("alpine: collapse min height must not be negative, got %d", px)
+		return c
+	}
+
+	c.modifiers = c.modifiers.with("min", strconv.Itoa(px)+"px")
+
+	return c
+}
+
+// Err reports whether the modifiers are valid.
+func (c Collapsing) Err() error {
+	return c.err
+}
+
+// XCollapse renders x-collapse with the configured modifiers.
+// It panics if the modifiers are invalid; use Err to check first.
+func (c Collapsing) XCollapse() html.Global {
+	if err := c.Err(); err != nil {
+		panic(err)
+	}
+
+	return html.ACustom("x-collapse"+c.modifiers.String(), "true")
+}
@@ -0,0 +1,34 @@
+package alpine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/plainkit/html"
+)
+
+// customAttrs returns the custom attributes set by g, including the
+// valueless ones html.Render omits.
+func customAttrs(g html.Global) map[string]string {
+	var ga html.GlobalAttrs
+	g.Do(&ga)
+
+	return ga.Custom
+}
+
+func TestCollapse(t *testing.T) {
+	tests := map[string]html.Global{
+		`<div x-collapse="true"></div>`:                         XCollapse(),
+		`<div x-collapse.duration.400ms.min.48px="true"></div>`: Collapse().Duration(400 * time.Millisecond).Min(48).XCollapse(),
+	}
+
+	for want, g := range tests {
+		if got := renderAttrs(g); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+
+	if Collapse().Duration(0).Err() == nil || Collapse().Duration(time.Microsecond).Err() == nil || Collapse().Min(-1).Err() == nil {
+		t.Error("expected invalid modifiers to be rejected")
+	}
+}
@@ -0,0 +1,145 @@
+package alpine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/plainkit/html"
+)
+
+// ComponentDef is a reusable Alpine component registered with Alpine.data.
+// T is the type of its state, which also types the initial values passed
+// from XDataWith.
+type ComponentDef[T any] struct {
+	name    string
+	state   T
+	methods []string
+}
+
+// Component defines a component named name whose data is state (serialized
+// as with JSObject) plus the given raw JavaScript methods and getters.
+// Add it to a Registry and reference it from elements with XData or XDataWith.
+//
+//	todos := alpine.Component("todoList", TodoState{}, "add() { this.todos.push(this.draft) }")
+//	reg.Register(todos)
+//	html.Div(todos.XData(), ...)
+func Component[T any](name string, state T, methods ...string) ComponentDef[T] {
+	return ComponentDef[T]{name: name, state: state, methods: methods}
+}
+
+// Name returns the registered component name.
+func (c ComponentDef[T]) Name() string {
+	return c.name
+}
+
+// XData points an element at the registered component: x-data="todoList".
+func (c ComponentDef[T]) XData() html.Global {
+	return directive("x-data", c.name)
+}
+
+// XDataWith points an element at the registered component and overrides its
+// state with the fields of initial that are not zero values:
+// x-data="todoList({...})". Fields left at their zero value keep the
+// registered default, so a field cannot be reset to its zero value this way.
+// It panics if initial cannot be serialized to an object.
+func (c ComponentDef[T]) XDataWith(initial T) html.Global {
+	obj, err := initialOverrides(initial)
+	if err != nil {
+		panic(err)
+	}
+
+	return directive("x-data", c.name+"("+obj+")")
+}
+
+// initialOverrides serializes the top-level fields of initial whose JSON differs
+// from that of its type's zero value, in encoding order. A DataObject is
+// compared by its underlying value.
+func initialOverrides(initial any) (string, error) {
+	if d, ok := initial.(DataObject); ok {
+		initial = d.value
+	}
+
+	if initial == nil {
+		return "{}", nil
+	}
+
+	raw, err := json.Marshal(initial)
+	if err != nil {
+		return "", fmt.Errorf("alpine: serializing %T: %w", initial, err)
+	}
+
+	if bytes.Equal(raw, []byte("null")) {
+		return "{}", nil
+	}
+
+	if raw[0] != '{' {
+		return "", fmt.Errorf("alpine: %T does not serialize to an object", initial)
+	}
+
+	zero := map[string]json.RawMessage{}
+	if b, err := json.Marshal(reflect.Zero(reflect.TypeOf(initial)).Interface()); err == nil {
+		_ = json.Unmarshal(b, &zero)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	_, _ = dec.Token() // {
+
+	var parts []string
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return "", err
+		}
+
+		field := tok.(string)
+		if z, ok := zero[field]; ok && bytes.Equal(z, value) {
+			continue
+		}
+
+		name, _ := json.Marshal(field)
+		parts = append(parts, string(name)+":"+string(value))
+	}
+
+	return "{" + strings.Join(parts, ",") + "}", nil
+}
+
+func (c ComponentDef[T]) data() any {
+	return c.state
+}
+
+func (c ComponentDef[T]) register(r *Registry) error {
+	factory, err := c.factory()
+	if err != nil {
+		return err
+	}
+
+	return r.addData(c.name, factory)
+}
+
+// factory renders the Alpine.data callback. Initial values are spread over
+// the state but before the methods, so they cannot replace behaviour.
+func (c ComponentDef[T]) factory() (string, error) {
+	state, err := jsObject(c.state, "Alpine.$persist", nil)
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{}
+	if fields := state[1 : len(state)-1]; fields != "" {
+		parts = append(parts, fields)
+	}
+
+	parts = append(parts, "...initial")
+	parts = append(parts, trimMembers(c.methods)...)
+
+	return "(initial = {}) => ({" + strings.Join(parts, ", ") + "})", nil
+}
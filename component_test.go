@@ -0,0 +1,66 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+)
+
+type counterState struct {
+	Count int    `json:"count"`
+	Label string `json:"label"`
+}
+
+func TestRegistryComponents(t *testing.T) {
+	reg := NewRegistry()
+	counter := Component("counter", counterState{Label: "Clicks"}, "inc() { this.count++ }")
+	empty := Component("accordion", struct{}{})
+
+	if err := reg.Register(counter, empty); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "document.addEventListener('alpine:init', () => {\n" +
+		`Alpine.data("accordion", (initial = {}) => ({...initial}));` + "\n" +
+		`Alpine.data("counter", (initial = {}) => ({"count":0,"label":"Clicks", ...initial, inc() { this.count++ }}));` + "\n" +
+		"});"
+
+	got, err := reg.JS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != want {
+		t.Errorf("JS() =\n%s\nwant\n%s", got, want)
+	}
+
+	// Zero fields are left out so they keep the registered defaults.
+	out := renderAttrs(counter.XData(), counter.XDataWith(counterState{Count: 5}))
+	if !strings.Contains(out, `x-data="counter({&#34;count&#34;:5})"`) {
+		t.Errorf("unexpected x-data in %s", out)
+	}
+
+	out = renderAttrs(counter.XDataWith(counterState{Label: "Taps"}))
+	if !strings.Contains(out, `x-data="counter({&#34;label&#34;:&#34;Taps&#34;})"`) {
+		t.Errorf("unexpected x-data in %s", out)
+	}
+}
+
+func TestRegistryRejectsDuplicatesAndBadNames(t *testing.T) {
+	reg := NewRegistry()
+
+	if err := reg.Register(Component("counter", counterState{})); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reg.Register(Component("counter", counterState{})); err == nil {
+		t.Error("expected duplicate name error")
+	}
+
+	if err := reg.Register(Component("todo-list", counterState{})); err == nil {
+		t.Error("expected invalid name error")
+	}
+
+	if err := reg.Register(Component("list", []int{1})); err == nil {
+		t.Error("expected non-object state error")
+	}
+}
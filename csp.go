@@ -0,0 +1,182 @@
+package alpine
+
+import (
+	"errors"
+	"fmt"
+	stdhtml "html"
+	"regexp"
+	"strings"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+var (
+	cspPath = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*(\.[A-Za-z_$][A-Za-z0-9_$]*)*$`)
+	cspFor  = regexp.MustCompile(`^(?:[A-Za-z_$][A-Za-z0-9_$]*|\(\s*[A-Za-z_$][A-Za-z0-9_$]*(?:\s*,\s*[A-Za-z_$][A-Za-z0-9_$]*){0,2}\s*\))\s+(?:in|of)\s+[A-Za-z_$][A-Za-z0-9_$]*(\.[A-Za-z_$][A-Za-z0-9_$]*)*$`)
+)
+
+// CheckCSP reports every Alpine directive in n whose expression the CSP
+// build (see CSPBuild) cannot evaluate, so logic has to move into
+// Alpine.data registrations (see Component). It inspects the rendered
+// markup and keeps no state, so pages served with different builds can be
+// checked side by side; call it in tests (see alpinetest.AssertCSP) or
+// before writing a page.
+//
+//	if err := alpine.CheckCSP(page); err != nil {
+//		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+//		log.Print(err)
+//		return
+//	}
+func CheckCSP(n html.Component) error {
+	var errs []error
+
+	for _, a := range renderedAttrs(html.Render(n)) {
+		if !isExpressionDirective(a.name) {
+			continue
+		}
+
+		if err := checkCSPDirective(a.name, a.value); err != nil {
+			errs = append(errs, fmt.Errorf("%w (in %s)", err, a.name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckCSPExpression reports whether the CSP build can evaluate expression:
+// only property paths such as "open" or "$store.cart.count", and method
+// names such as "toggle", are allowed.
+func CheckCSPExpression(expression string) error {
+	if expression == "" || cspPath.MatchString(expression) {
+		return nil
+	}
+
+	return fmt.Errorf("alpine: %q is not a property path or method name, which the CSP build requires", expression)
+}
+
+// Expression is the type of directive arguments: JavaScript source as a
+// string, or an expression built with package expr.
+type Expression interface {
+	string | expr.Expr
+}
+
+// directive renders an Alpine directive whose value is a JavaScript
+// expression.
+func directive[E Expression](name string, expression E) html.Global {
+	return html.ACustom(name, source(expression))
+}
+
+// source returns the JavaScript source of an Expression.
+func source[E Expression](expression E) string {
+	if e, ok := any(expression).(expr.Expr); ok {
+		return e.String()
+	}
+
+	return any(expression).(string)
+}
+
+// checkCSPDirective is CheckCSPExpression with x-for's "item in items"
+// syntax allowed.
+func checkCSPDirective(name, expression string) error {
+	if name == "x-for" && cspFor.MatchString(expression) {
+		return nil
+	}
+
+	return CheckCSPExpression(expression)
+}
+
+// literalDirectives are the Alpine directives whose values are not
+// expressions, by name without the x- prefix and modifiers.
+var literalDirectives = map[string]bool{
+	"cloak":       true,
+	"collapse":    true,
+	"ignore":      true,
+	"mask":        true,
+	"ref":         true,
+	"sort:group":  true,
+	"sort:handle": true,
+	"sort:ignore": true,
+	"teleport":    true,
+	"transition":  true,
+}
+
+// isExpressionDirective reports whether the attribute name is an Alpine
+// directive whose value Alpine evaluates.
+func isExpressionDirective(name string) bool {
+	if strings.HasPrefix(name, "@") || strings.HasPrefix(name, ":") {
+		return true
+	}
+
+	rest, ok := strings.CutPrefix(name, "x-")
+	if !ok {
+		return false
+	}
+
+	rest, _, _ = strings.Cut(rest, ".")
+	if base, _, _ := strings.Cut(rest, ":"); base == "transition" {
+		return false
+	}
+
+	return !literalDirectives[rest]
+}
+
+type renderedAttr struct {
+	name, value string
+}
+
+// renderedAttrs returns the attributes of every element in markup produced
+// by html.Render, which always quotes values with " and escapes them.
+// The contents of <script> and <style> elements are skipped.
+func renderedAttrs(markup string) []renderedAttr {
+	var attrs []renderedAttr
+
+	for {
+		i := strings.IndexByte(markup, '<')
+		if i < 0 || i+1 >= len(markup) {
+			return attrs
+		}
+
+		markup = markup[i+1:]
+		if c := markup[0]; !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			continue
+		}
+
+		end := strings.IndexAny(markup, " />")
+		if end < 0 {
+			return attrs
+		}
+
+		tag := strings.ToLower(markup[:end])
+		markup = markup[end:]
+
+		for {
+			markup = strings.TrimLeft(markup, " /")
+			if markup == "" || markup[0] == '>' {
+				break
+			}
+
+			end := strings.IndexAny(markup, ` =/>`)
+			if end < 0 {
+				return attrs
+			}
+
+			a := renderedAttr{name: markup[:end]}
+			markup = markup[end:]
+
+			if strings.HasPrefix(markup, `="`) {
+				value, rest, _ := strings.Cut(markup[2:], `"`)
+				a.value = stdhtml.UnescapeString(value)
+				markup = rest
+			}
+
+			attrs = append(attrs, a)
+		}
+
+		if tag == "script" || tag == "style" {
+			if end := strings.Index(markup, "</"+tag); end >= 0 {
+				markup = markup[end:]
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package alpine
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestCheckCSPExpression(t *testing.T) {
+	for _, ok := range []string{"", "open", "toggle", "user.name", "$store.cart.count", "$refs.button"} {
+		if err := CheckCSPExpression(ok); err != nil {
+			t.Errorf("CheckCSPExpression(%q): %v", ok, err)
+		}
+	}
+
+	for _, bad := range []string{"open = !open", "toggle()", "{ open: false }", "count > 0", "items[0]", "a..b"} {
+		if CheckCSPExpression(bad) == nil {
+			t.Errorf("CheckCSPExpression(%q): expected error", bad)
+		}
+	}
+}
+
+func TestCheckCSP(t *testing.T) {
+	// Property paths, method names, x-for loops and literal directives pass.
+	ok := html.Div(
+		XShow("open"),
+		AtClick("toggle"),
+		XFor("(todo, index) in todos"),
+		Component("dropdown", struct{}{}).XData(),
+		XTransitionEnter("transition ease-out duration-300"),
+		XRef("panel"),
+		XMask("99/99"),
+		html.ATitle("a = b"),
+		html.Script(html.UnsafeText(`el.setAttribute("x-show", "!open")`)),
+	)
+
+	if err := CheckCSP(ok); err != nil {
+		t.Errorf("CheckCSP: %v", err)
+	}
+
+	bad := html.Div(
+		XShow("!open"),
+		html.Ul(XFor("i in [1, 2, 3]")),
+		html.Span(XDataOf(map[string]bool{"open": false})),
+		html.Button(On("click").Prevent().Handler("open = true")),
+	)
+
+	err := CheckCSP(bad)
+	if err == nil {
+		t.Fatal("CheckCSP accepted expressions the CSP build cannot run")
+	}
+
+	for _, name := range []string{"x-show", "x-for", "x-data", "x-on:click.prevent"} {
+		if !strings.Contains(err.Error(), "(in "+name+")") {
+			t.Errorf("no error for %s in:\n%v", name, err)
+		}
+	}
+}
+
+func TestCSPBuild(t *testing.T) {
+	body := JavaScript(CSPBuild())
+	if body == nil {
+		t.Fatal("alpine-csp.min.js is not vendored; run go generate ./js")
+	}
+
+	if bytes.Equal(body, JavaScript()) {
+		t.Error("CSP build is identical to the standard build")
+	}
+
+	if !bytes.Contains(body, []byte(`version:"`+Version+`"`)) {
+		t.Errorf("CSP build is not Alpine %s", Version)
+	}
+
+	rec := httptest.NewRecorder()
+	Handler(CSPBuild()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/alpine.min.js", nil))
+
+	if !bytes.Equal(rec.Body.Bytes(), body) {
+		t.Error("Handler(CSPBuild()) does not serve the CSP build")
+	}
+}
@@ -0,0 +1,101 @@
+package alpine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/plainkit/html"
+)
+
+// JSObject serializes v to a JavaScript object literal suitable for x-data.
+//
+// v is marshalled with encoding/json, so struct tags, maps (with sorted keys),
+// slices and time.Time behave as usual and a nil v yields an empty object.
+// The output escapes <, >, &, U+2028 and U+2029, which keeps it safe inside
+// HTML attributes and <script> blocks alike.
+//
+// v may also be a DataObject, whose persisted fields are wrapped in $persist.
+// members are raw JavaScript object members such as methods or getters,
+// appended after the serialized fields:
+//
+//	JSObject(state, "toggle() { this.open = !this.open }", "get count() { return this.items.length }")
+func JSObject(v any, members ...string) (string, error) {
+	return jsObject(v, "$persist", members)
+}
+
+// jsObject implements JSObject. persist is the expression used to reach the
+// Persist plugin: "$persist" inside x-data, "Alpine.$persist" in scripts.
+func jsObject(v any, persist string, members []string) (string, error) {
+	obj := []byte("{}")
+
+	if d, ok := v.(DataObject); ok {
+		s, err := d.js(persist)
+		if err != nil {
+			return "", err
+		}
+
+		obj = []byte(s)
+	} else if v != nil {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("alpine: serializing %T: %w", v, err)
+		}
+
+		obj = b
+		if bytes.Equal(obj, []byte("null")) {
+			obj = []byte("{}")
+		}
+
+		if obj[0] != '{' {
+			return "", fmt.Errorf("alpine: %T does not serialize to an object", v)
+		}
+	}
+
+	extra := trimMembers(members)
+	if len(extra) == 0 {
+		return string(obj), nil
+	}
+
+	var sb strings.Builder
+
+	sb.Write(obj[:len(obj)-1])
+
+	if len(obj) > 2 {
+		sb.WriteString(", ")
+	}
+
+	sb.WriteString(strings.Join(extra, ", "))
+	sb.WriteString("}")
+
+	return sb.String(), nil
+}
+
+// XDataOf declares a component whose data is serialized from a Go value.
+// See JSObject for the serialization rules. It panics if v cannot be
+// serialized to an object, which is a programming error.
+// Example: XDataOf(Todo{Title: "Buy milk"}, "toggle() { this.done = !this.done }")
+func XDataOf(v any, members ...string) html.Global {
+	obj, err := JSObject(v, members...)
+	if err != nil {
+		panic(err)
+	}
+
+	return directive("x-data", obj)
+}
+
+// trimMembers drops blank members and trailing commas so callers can pass
+// members exactly as they would write them inside an object literal.
+func trimMembers(members []string) []string {
+	out := make([]string, 0, len(members))
+
+	for _, m := range members {
+		m = strings.TrimSuffix(strings.TrimSpace(m), ",")
+		if m != "" {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,59 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type todo struct {
+	Title   string    `json:"title"`
+	Done    bool      `json:"done"`
+	Due     time.Time `json:"due"`
+	Tags    []string  `json:"tags,omitempty"`
+	private string
+}
+
+func TestJSObject(t *testing.T) {
+	due := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		v       any
+		members []string
+		want    string
+	}{
+		{"nil", nil, nil, `{}`},
+		{"struct", todo{Title: "Milk", Due: due}, nil, `{"title":"Milk","done":false,"due":"2025-01-02T03:04:05Z"}`},
+		{"map", map[string]any{"b": 1, "a": nil}, nil, `{"a":null,"b":1}`},
+		{"members", map[string]int{"n": 1}, []string{"inc() { this.n++ },", " "}, `{"n":1, inc() { this.n++ }}`},
+		{"only members", nil, []string{"get x() { return 1 }"}, `{get x() { return 1 }}`},
+		{"escaping", map[string]string{"s": "</script>\u2028'\""}, nil, `{"s":"\u003c/script\u003e\u2028'\""}`},
+	}
+
+	for _, tt := range tests {
+		got, err := JSObject(tt.v, tt.members...)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	for _, bad := range []any{[]int{1}, 42, make(chan int)} {
+		if _, err := JSObject(bad); err == nil {
+			t.Errorf("JSObject(%T): expected error", bad)
+		}
+	}
+}
+
+func TestXDataOfEscapesAttribute(t *testing.T) {
+	out := renderAttrs(XDataOf(map[string]string{"name": `O'Brien "Bob"`}))
+
+	if strings.Contains(out, `"Bob"`) || strings.Contains(out, "'Brien") {
+		t.Errorf("quotes not escaped in %s", out)
+	}
+}
@@ -8,13 +8,65 @@ import (
 	. "github.com/plainkit/html"
 )
 
+type todo struct {
+	ID        int    `json:"id"`
+	Text      string `json:"text"`
+	Completed bool   `json:"completed"`
+}
+
+type todoState struct {
+	NewTodo string `json:"newTodo"`
+	Todos   []todo `json:"todos"`
+	NextID  int    `json:"nextId"`
+}
+
+var todoList = alpine.Component("todoList",
+	todoState{
+		Todos: []todo{
+			{ID: 1, Text: "Learn Alpine.js"},
+			{ID: 2, Text: "Build awesome UI"},
+			{ID: 3, Text: "Deploy to production", Completed: true},
+		},
+		NextID: 4,
+	},
+	`addTodo() {
+		if (this.newTodo.trim()) {
+			this.todos.push({
+				id: this.nextId++,
+				text: this.newTodo.trim(),
+				completed: false
+			});
+			this.newTodo = '';
+		}
+	}`,
+	`deleteTodo(id) {
+		this.todos = this.todos.filter(todo => todo.id !== id);
+	}`,
+	`toggleTodo(id) {
+		const todo = this.todos.find(t => t.id === id);
+		if (todo) todo.completed = !todo.completed;
+	}`,
+	`get completedCount() {
+		return this.todos.filter(t => t.completed).length;
+	}`,
+	`get totalCount() {
+		return this.todos.length;
+	}`,
+)
+
 func main() {
+	registry := alpine.NewRegistry()
+	if err := registry.Register(todoList); err != nil {
+		panic(err)
+	}
+
+	registrations, err := registry.Script()
+	if err != nil {
+		panic(err)
+	}
+
 	// Serve Alpine.js library
-	http.HandleFunc("/js/alpine.min.js", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/javascript")
-		w.Header().Set("Cache-Control", "public, max-age=31536000")
-		_, _ = w.Write(alpine.JavaScript())
-	})
+	http.Handle("/js/", alpine.AssetHandler())
 
 	// Main page
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -23,7 +75,8 @@ func main() {
 				Meta(ACharset("utf-8")),
 				Meta(AName("viewport"), AContent("width=device-width, initial-scale=1.0")),
 				Title(Text("Todo App")),
-				Script(ASrc("/js/alpine.min.js"), ADefer()),
+				registrations,
+				alpine.Script(),
 				Style(Text(`
 					@import url('https://fonts.googleapis.com/css2?family=Inter:ital,wght@0,100;0,200;0,300;0,400;0,500;0,600;0,700;0,800;0,900;1,100;1,200;1,300;1,400;1,500;1,600;1,700;1,800;1,900&display=swap');
 
@@ -229,38 +282,7 @@ func main() {
 			),
 			Body(
 				Div(AClass("container"),
-					alpine.XData(`{
-							newTodo: '',
-							todos: [
-								{id: 1, text: 'Learn Alpine.js', completed: false},
-								{id: 2, text: 'Build awesome UI', completed: false},
-								{id: 3, text: 'Deploy to production', completed: true}
-							],
-							nextId: 4,
-							addTodo() {
-								if (this.newTodo.trim()) {
-									this.todos.push({
-										id: this.nextId++,
-										text: this.newTodo.trim(),
-										completed: false
-									});
-									this.newTodo = '';
-								}
-							},
-							deleteTodo(id) {
-								this.todos = this.todos.filter(todo => todo.id !== id);
-							},
-							toggleTodo(id) {
-								const todo = this.todos.find(t => t.id === id);
-								if (todo) todo.completed = !todo.completed;
-							},
-							get completedCount() {
-								return this.todos.filter(t => t.completed).length;
-							},
-							get totalCount() {
-								return this.todos.length;
-							}
-						}`),
+					todoList.XData(),
 
 					H1(Text("Todo App")),
 					P(AClass("subtitle"), Text("Stay organized and productive")),
@@ -299,7 +321,7 @@ func main() {
 								),
 								Span(
 									AClass("todo-text"),
-									alpine.XBindClass("{'completed': todo.completed}"),
+									alpine.Classes().When("completed", "todo.completed").XBindClass(),
 									alpine.XText("todo.text"),
 								),
 								Button(
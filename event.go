@@ -0,0 +1,182 @@
+package alpine
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// Event builds an x-on / @ listener with typed modifiers.
+// Builders are immutable, so a partially configured Event can be shared:
+//
+//	save := alpine.On("click").Prevent().Stop()
+//	save.Handler("save()") // x-on:click.prevent.stop="save()"
+//	save.At("save()")      // @click.prevent.stop="save()"
+type Event struct {
+	name      string
+	keys      KeyCombo
+	modifiers modifiers
+	err       error
+}
+
+// eventModifierConflicts lists modifier pairs Alpine cannot honour together.
+var eventModifierConflicts = [][2]string{
+	{"window", "document"},
+	{"window", "outside"},
+	{"document", "outside"},
+	{"self", "outside"},
+	{"debounce", "throttle"},
+	{"passive", "prevent"},
+	{"camel", "dot"},
+}
+
+// On starts an event listener builder for the given event name.
+// Example: On("click").Prevent().Once().Handler("submit()")
+func On(event string) Event {
+	return Event{name: event}
+}
+
+// Prevent calls event.preventDefault().
+func (e Event) Prevent() Event { return e.with("prevent", "") }
+
+// Stop calls event.stopPropagation().
+func (e Event) Stop() Event { return e.with("stop", "") }
+
+// Outside only fires for events originating outside the element.
+func (e Event) Outside() Event { return e.with("outside", "") }
+
+// Window registers the listener on the window object.
+func (e Event) Window() Event { return e.with("window", "") }
+
+// Document registers the listener on the document object.
+func (e Event) Document() Event { return e.with("document", "") }
+
+// Once removes the listener after the first call.
+func (e Event) Once() Event { return e.with("once", "") }
+
+// Self only fires when the event target is the element itself.
+func (e Event) Self() Event { return e.with("self", "") }
+
+// Camel converts a kebab-case event name to camelCase.
+func (e Event) Camel() Event { return e.with("camel", "") }
+
+// Dot converts dashes in the event name to dots.
+func (e Event) Dot() Event { return e.with("dot", "") }
+
+// Passive registers a passive listener, which cannot prevent the default action.
+func (e Event) Passive() Event { return e.with("passive", "") }
+
+// Capture registers the listener in the capture phase.
+func (e Event) Capture() Event { return e.with("capture", "") }
+
+// Debounce delays the handler until the event stops firing for d.
+// A zero duration uses Alpine's default of 250ms; other durations must be
+// at least 1ms, as Alpine counts whole milliseconds.
+func (e Event) Debounce(d time.Duration) Event { return e.withDuration("debounce", d) }
+
+// Throttle calls the handler at most once per d.
+// A zero duration uses Alpine's default of 250ms; other durations must be
+// at least 1ms.
+func (e Event) Throttle(d time.Duration) Event { return e.withDuration("throttle", d) }
+
+// Name returns the attribute suffix, e.g. "click.prevent.stop".
+func (e Event) Name() string {
+	var sb strings.Builder
+
+	sb.WriteString(e.name)
+
+	for _, k := range e.keys {
+		sb.WriteString(".")
+		sb.WriteString(string(k))
+	}
+
+	sb.WriteString(e.modifiers.String())
+
+	return sb.String()
+}
+
+// Err reports why the listener cannot be rendered, or nil if it is valid.
+func (e Event) Err() error {
+	if e.name == "" {
+		return errors.New("alpine: event name is empty")
+	}
+
+	if strings.ContainsAny(e.name, " \t\n.\"'=<>/") {
+		return fmt.Errorf("alpine: invalid event name %q", e.name)
+	}
+
+	if e.err != nil {
+		return e.err
+	}
+
+	for _, c := range eventModifierConflicts {
+		if e.has(c[0]) && e.has(c[1]) {
+			return fmt.Errorf("alpine: .%s cannot be combined with .%s on %q", c[0], c[1], e.name)
+		}
+	}
+
+	return e.keysErr()
+}
+
+// Handler renders the listener in x-on: form.
+// It panics if the modifier combination is invalid; use Err to check first.
+func (e Event) Handler(handler string) html.Global {
+	return directive("x-on:"+e.mustName(), handler)
+}
+
+// At renders the listener in @ shorthand form.
+// It panics if the modifier combination is invalid; use Err to check first.
+func (e Event) At(handler string) html.Global {
+	return directive("@"+e.mustName(), handler)
+}
+
+// HandlerExpr is Handler for an expression built with package expr.
+func (e Event) HandlerExpr(handler expr.Expr) html.Global {
+	return directive("x-on:"+e.mustName(), handler)
+}
+
+// AtExpr is At for an expression built with package expr.
+func (e Event) AtExpr(handler expr.Expr) html.Global {
+	return directive("@"+e.mustName(), handler)
+}
+
+func (e Event) mustName() string {
+	if err := e.Err(); err != nil {
+		panic(err)
+	}
+
+	return e.Name()
+}
+
+func (e Event) has(name string) bool {
+	return e.modifiers.has(name)
+}
+
+func (e Event) with(name, arg string) Event {
+	e.modifiers = e.modifiers.with(name, arg)
+	return e
+}
+
+func (e Event) withDuration(name string, d time.Duration) Event {
+	if d < 0 {
+		e.err = fmt.Errorf("alpine: .%s duration must not be negative, got %s", name, d)
+		return e
+	}
+
+	if d == 0 {
+		return e.with(name, "")
+	}
+
+	// Anything shorter would render as .0ms, which Alpine reads as no delay.
+	if d < time.Millisecond {
+		e.err = fmt.Errorf("alpine: .%s duration must be at least 1ms, got %s", name, d)
+		return e
+	}
+
+	return e.with(name, strconv.FormatInt(d.Milliseconds(), 10)+"ms")
+}
@@ -0,0 +1,85 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/plainkit/html"
+)
+
+func renderAttrs(attrs ...html.Global) string {
+	args := make([]html.DivArg, len(attrs))
+	for i, a := range attrs {
+		args[i] = a
+	}
+
+	return html.Render(html.Div(args...))
+}
+
+func TestEventModifiers(t *testing.T) {
+	tests := []struct {
+		event Event
+		want  string
+	}{
+		{On("click"), "click"},
+		{On("click").Prevent().Stop().Once(), "click.prevent.stop.once"},
+		{On("input").Debounce(300 * time.Millisecond), "input.debounce.300ms"},
+		{On("scroll").Throttle(0).Window().Passive(), "scroll.throttle.window.passive"},
+		{On("custom-event").Camel().Self().Capture(), "custom-event.camel.self.capture"},
+		{On("click").Outside().Once().Outside(), "click.once.outside"},
+		{On("foo-bar").Dot().Document(), "foo-bar.dot.document"},
+	}
+
+	for _, tt := range tests {
+		if err := tt.event.Err(); err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.want, err)
+		}
+
+		if got := tt.event.Name(); got != tt.want {
+			t.Errorf("Name() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestEventRendersBothForms(t *testing.T) {
+	e := On("click").Prevent()
+	out := renderAttrs(e.Handler("save()"), e.At("close()"))
+
+	if !strings.Contains(out, `x-on:click.prevent="save()"`) {
+		t.Errorf("missing x-on form in %s", out)
+	}
+
+	if !strings.Contains(out, `@click.prevent="close()"`) {
+		t.Errorf("missing @ form in %s", out)
+	}
+}
+
+func TestEventRejectsImpossibleCombinations(t *testing.T) {
+	invalid := []Event{
+		On(""),
+		On("click.prevent"),
+		On("click").Window().Outside(),
+		On("click").Window().Document(),
+		On("click").Self().Outside(),
+		On("input").Debounce(time.Second).Throttle(time.Second),
+		On("touchstart").Passive().Prevent(),
+		On("input").Debounce(-time.Second),
+		On("input").Debounce(500 * time.Microsecond),
+		On("scroll").Throttle(time.Nanosecond),
+	}
+
+	for _, e := range invalid {
+		if e.Err() == nil {
+			t.Errorf("%q: expected error", e.Name())
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Handler did not panic on invalid combination")
+		}
+	}()
+
+	On("click").Window().Outside().Handler("close()")
+}
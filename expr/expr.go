@@ -0,0 +1,280 @@
+// Package expr builds JavaScript expressions for Alpine.js directives.
+//
+// Expressions are values that render with the parentheses their operator
+// precedence requires, so they compose without string surgery:
+//
+//	count := expr.Ident("count")
+//	count.Gt(expr.Value(0)).And(expr.Ident("open"))   // count > 0 && open
+//	count.Set(count.Add(expr.Value(1)))               // count = count + 1
+//	expr.Field[State]("count")                        // panics unless State has a "count" field
+//
+// Every directive helper in package alpine accepts an Expr wherever it takes
+// a JavaScript string. Constructors panic on invalid input, like the
+// directive helpers themselves.
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Operator precedence levels, following the ECMAScript grammar.
+const (
+	precSequence = iota + 1
+	precAssign   // also ternaries
+	precOr
+	precAnd
+	precEquality
+	precRelational
+	precAdditive
+	precUnary
+	precLiteral // numeric literals: safe everywhere except before a dot
+	precMember
+	precPrimary
+)
+
+// Expr is a JavaScript expression. The zero value is not valid.
+type Expr struct {
+	src        string
+	prec       int
+	assignable bool // identifiers and property accesses
+}
+
+var identPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// String returns the JavaScript source.
+func (e Expr) String() string {
+	return e.src
+}
+
+// MarshalText lets an Expr be used where text is expected, e.g. in templates.
+func (e Expr) MarshalText() ([]byte, error) {
+	return []byte(e.src), nil
+}
+
+// Ident returns a reference to a variable, state field, magic or method,
+// e.g. Ident("open") or Ident("$refs"). It panics if name is not a valid
+// JavaScript identifier.
+func Ident(name string) Expr {
+	if !identPattern.MatchString(name) {
+		panic(fmt.Errorf("alpine/expr: %q is not a valid JavaScript identifier", name))
+	}
+
+	return Expr{src: name, prec: precPrimary, assignable: true}
+}
+
+// Field is Ident for a field of the component state T, named as in its JSON
+// encoding. It panics if T has no such field, so renaming a field breaks at
+// the first render rather than silently in the browser.
+func Field[T any](name string) Expr {
+	if !hasField(reflect.TypeOf((*T)(nil)).Elem(), name) {
+		var zero T
+		panic(fmt.Errorf("alpine/expr: %T has no field %q", zero, name))
+	}
+
+	return Ident(name)
+}
+
+// Raw wraps JavaScript source as is. It is parenthesised whenever it is
+// combined with another expression.
+func Raw(src string) Expr {
+	return Expr{src: src, prec: precSequence}
+}
+
+// Value returns v encoded as a JavaScript literal using encoding/json, e.g.
+// Value("hi") is "hi" and Value(nil) is null. It panics if v cannot be
+// encoded.
+func Value(v any) Expr {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Errorf("alpine/expr: %w", err))
+	}
+
+	src := string(b)
+
+	switch {
+	case strings.HasPrefix(src, "-"):
+		return Expr{src: src, prec: precUnary}
+	case src[0] >= '0' && src[0] <= '9':
+		return Expr{src: src, prec: precLiteral}
+	default:
+		return Expr{src: src, prec: precPrimary}
+	}
+}
+
+// Get returns the property access e.name, or e["name"] when name is not an
+// identifier.
+func (e Expr) Get(name string) Expr {
+	if identPattern.MatchString(name) {
+		return Expr{src: e.wrap(precMember) + "." + name, prec: precMember, assignable: true}
+	}
+
+	return e.Index(Value(name))
+}
+
+// Index returns the computed member access e[key].
+func (e Expr) Index(key Expr) Expr {
+	return Expr{src: e.wrap(precMember) + "[" + key.wrap(precAssign) + "]", prec: precMember, assignable: true}
+}
+
+// Call returns the method call e.method(args...).
+func (e Expr) Call(method string, args ...Expr) Expr {
+	return Call(e.Get(method), args...)
+}
+
+// Call returns the function call fn(args...).
+func Call(fn Expr, args ...Expr) Expr {
+	list := make([]string, len(args))
+	for i, arg := range args {
+		list[i] = arg.wrap(precAssign)
+	}
+
+	return Expr{src: fn.wrap(precMember) + "(" + strings.Join(list, ", ") + ")", prec: precMember}
+}
+
+// Eq returns e === o.
+func (e Expr) Eq(o Expr) Expr { return binary(e, "===", o, precEquality) }
+
+// Ne returns e !== o.
+func (e Expr) Ne(o Expr) Expr { return binary(e, "!==", o, precEquality) }
+
+// Lt returns e < o.
+func (e Expr) Lt(o Expr) Expr { return binary(e, "<", o, precRelational) }
+
+// Le returns e <= o.
+func (e Expr) Le(o Expr) Expr { return binary(e, "<=", o, precRelational) }
+
+// Gt returns e > o.
+func (e Expr) Gt(o Expr) Expr { return binary(e, ">", o, precRelational) }
+
+// Ge returns e >= o.
+func (e Expr) Ge(o Expr) Expr { return binary(e, ">=", o, precRelational) }
+
+// Add returns e + o.
+func (e Expr) Add(o Expr) Expr { return binary(e, "+", o, precAdditive) }
+
+// Sub returns e - o.
+func (e Expr) Sub(o Expr) Expr { return binary(e, "-", o, precAdditive) }
+
+// And returns e && o.
+func (e Expr) And(o Expr) Expr { return binary(e, "&&", o, precAnd) }
+
+// Or returns e || o.
+func (e Expr) Or(o Expr) Expr { return binary(e, "||", o, precOr) }
+
+// Not returns !e.
+func (e Expr) Not() Expr {
+	return Expr{src: "!" + e.wrap(precUnary), prec: precUnary}
+}
+
+// Cond returns the ternary test ? then : otherwise.
+func Cond(test, then, otherwise Expr) Expr {
+	return Expr{
+		src:  test.wrap(precOr) + " ? " + then.wrap(precAssign) + " : " + otherwise.wrap(precAssign),
+		prec: precAssign,
+	}
+}
+
+// Set returns the assignment e = v. It panics unless e is an identifier or
+// a property access.
+func (e Expr) Set(v Expr) Expr {
+	if !e.assignable {
+		panic(fmt.Errorf("alpine/expr: cannot assign to %s", e.src))
+	}
+
+	return Expr{src: e.src + " = " + v.wrap(precAssign), prec: precAssign}
+}
+
+// Seq returns the expressions separated by commas, evaluated in order,
+// e.g. for an event handler doing several things.
+func Seq(exprs ...Expr) Expr {
+	list := make([]string, len(exprs))
+	for i, e := range exprs {
+		list[i] = e.wrap(precAssign)
+	}
+
+	return Expr{src: strings.Join(list, ", "), prec: precSequence}
+}
+
+// Template returns a template literal. Parts are strings, used literally,
+// or Exprs, interpolated with ${...}:
+//
+//	expr.Template("Hello, ", expr.Ident("name"), "!") // `Hello, ${name}!`
+//
+// It panics on parts of any other type.
+func Template(parts ...any) Expr {
+	var sb strings.Builder
+
+	sb.WriteByte('`')
+
+	for _, part := range parts {
+		switch p := part.(type) {
+		case string:
+			sb.WriteString(templateEscaper.Replace(p))
+		case Expr:
+			sb.WriteString("${" + p.src + "}")
+		default:
+			panic(fmt.Errorf("alpine/expr: template part must be a string or Expr, got %T", part))
+		}
+	}
+
+	sb.WriteByte('`')
+
+	return Expr{src: sb.String(), prec: precPrimary}
+}
+
+var templateEscaper = strings.NewReplacer("\\", "\\\\", "`", "\\`", "${", "\\${")
+
+// binary joins two operands with a left-associative operator.
+func binary(left Expr, op string, right Expr, prec int) Expr {
+	return Expr{src: left.wrap(prec) + " " + op + " " + right.wrap(prec+1), prec: prec}
+}
+
+// wrap returns the source, parenthesised if e binds more loosely than min.
+func (e Expr) wrap(min int) string {
+	if e.prec < min {
+		return "(" + e.src + ")"
+	}
+
+	return e.src
+}
+
+// hasField reports whether struct type t encodes a field called name.
+func hasField(t reflect.Type, name string) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		switch {
+		case tag == "-":
+			continue
+		case f.Anonymous && tag == "" && ft.Kind() == reflect.Struct:
+			// Fields of embedded structs are promoted.
+			if hasField(ft, name) {
+				return true
+			}
+		case !f.IsExported():
+			continue
+		case tag == name, tag == "" && f.Name == name:
+			return true
+		}
+	}
+
+	return false
+}
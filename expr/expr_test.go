@@ -0,0 +1,94 @@
+package expr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	count, open := Ident("count"), Ident("open")
+
+	tests := map[string]Expr{
+		"count > 0 && open":                    count.Gt(Value(0)).And(open),
+		"(a || b) && c":                        Ident("a").Or(Ident("b")).And(Ident("c")),
+		"a || b && c":                          Ident("a").Or(Ident("b").And(Ident("c"))),
+		"a - (b - c)":                          Ident("a").Sub(Ident("b").Sub(Ident("c"))),
+		"a - b - c":                            Ident("a").Sub(Ident("b")).Sub(Ident("c")),
+		"a - -1":                               Ident("a").Sub(Value(-1)),
+		"!(a === b)":                           Ident("a").Eq(Ident("b")).Not(),
+		"!open":                                open.Not(),
+		"count = count + 1":                    count.Set(count.Add(Value(1))),
+		"open ? \"Close\" : \"Open\"":          Cond(open, Value("Close"), Value("Open")),
+		"(a ? b : c) ? d : e":                  Cond(Cond(Ident("a"), Ident("b"), Ident("c")), Ident("d"), Ident("e")),
+		"$refs.input.focus()":                  Ident("$refs").Get("input").Call("focus"),
+		"$store.cart[\"line-items\"].length":   Ident("$store").Get("cart").Get("line-items").Get("length"),
+		"items[i]":                             Ident("items").Index(Ident("i")),
+		"add(a + b, (x = 1, y))":               Call(Ident("add"), Ident("a").Add(Ident("b")), Seq(Ident("x").Set(Value(1)), Ident("y"))),
+		"(1).toFixed(2)":                       Value(1).Call("toFixed", Value(2)),
+		"(a = 1).toString()":                   Ident("a").Set(Value(1)).Call("toString"),
+		"(raw code) && open":                   Raw("raw code").And(open),
+		"{\"a\":[1,2]}":                        Value(map[string]any{"a": []int{1, 2}}),
+		"\"\\u003c/script\\u003e\"":            Value("</script>"),
+		"open = false, count = 0":              Seq(open.Set(Value(false)), count.Set(Value(0))),
+		"`Hi ${name}, \\` \\${x} \\\\`":        Template("Hi ", Ident("name"), ", ` ${x} \\"),
+		"`${a + b}`":                           Template(Ident("a").Add(Ident("b"))),
+		"count >= 10 ? count - 10 : count = 0": Cond(count.Ge(Value(10)), count.Sub(Value(10)), count.Set(Value(0))),
+	}
+
+	for want, e := range tests {
+		if got := e.String(); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
+
+type state struct {
+	Count  int    `json:"count"`
+	Title  string // encoded as "Title"
+	Hidden bool   `json:"-"`
+	embedded
+}
+
+type embedded struct {
+	Open bool `json:"open"`
+}
+
+func TestField(t *testing.T) {
+	for _, name := range []string{"count", "Title", "open"} {
+		if got := Field[state](name).String(); got != name {
+			t.Errorf("Field(%q) = %q", name, got)
+		}
+	}
+
+	for _, name := range []string{"Count", "Hidden", "missing"} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil || !strings.Contains(r.(error).Error(), name) {
+					t.Errorf("Field(%q): recover() = %v", name, r)
+				}
+			}()
+
+			Field[state](name)
+		}()
+	}
+}
+
+func TestPanics(t *testing.T) {
+	for name, fn := range map[string]func(){
+		"bad identifier": func() { Ident("a.b") },
+		"assign literal": func() { Value(1).Set(Value(2)) },
+		"assign call":    func() { Ident("f").Call("g").Set(Value(1)) },
+		"template part":  func() { Template(1) },
+		"unencodable":    func() { Value(func() {}) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: did not panic", name)
+				}
+			}()
+
+			fn()
+		}()
+	}
+}
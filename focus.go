@@ -0,0 +1,102 @@
+package alpine
+
+import (
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// Focus plugin directives. They require PluginFocus to be loaded (see Scripts).
+
+// XTrap traps focus inside the element while the expression is true.
+// Example: XTrap("open")
+func XTrap[E Expression](expression E) html.Global {
+	return directive("x-trap", expression)
+}
+
+// FocusTrap builds an x-trap directive with modifiers.
+//
+//	alpine.Trap().Inert().Noscroll().Handler("open")
+//	// x-trap.inert.noscroll="open"
+type FocusTrap struct {
+	modifiers modifiers
+}
+
+// Trap starts an x-trap builder.
+func Trap() FocusTrap {
+	return FocusTrap{}
+}
+
+// Inert sets aria-hidden on everything outside the trap.
+func (t FocusTrap) Inert() FocusTrap { return t.with("inert") }
+
+// Noscroll disables page scrolling while the trap is active.
+func (t FocusTrap) Noscroll() FocusTrap { return t.with("noscroll") }
+
+// Noreturn keeps focus where it is when the trap is released instead of
+// returning it to the previously focused element.
+func (t FocusTrap) Noreturn() FocusTrap { return t.with("noreturn") }
+
+// Noautofocus does not move focus into the trap when it activates.
+func (t FocusTrap) Noautofocus() FocusTrap { return t.with("noautofocus") }
+
+// Handler renders x-trap with the configured modifiers.
+func (t FocusTrap) Handler(expression string) html.Global {
+	return directive("x-trap"+t.modifiers.String(), expression)
+}
+
+// HandlerExpr is Handler for an expression built with package expr.
+func (t FocusTrap) HandlerExpr(expression expr.Expr) html.Global {
+	return directive("x-trap"+t.modifiers.String(), expression)
+}
+
+func (t FocusTrap) with(name string) FocusTrap {
+	t.modifiers = t.modifiers.with(name, "")
+	return t
+}
+
+// FocusExpr builds $focus expressions for use in handlers.
+//
+//	alpine.On("keydown").Keys(alpine.KeyArrowDown).Prevent().At(alpine.Focus().Wrap().Next())
+//	// @keydown.arrow-down.prevent="$focus.wrap().next()"
+type FocusExpr struct {
+	expr string
+}
+
+// Focus starts a $focus expression.
+func Focus() FocusExpr {
+	return FocusExpr{expr: "$focus"}
+}
+
+// Within scopes the following call to the focusable elements inside element,
+// e.g. Within("$refs.menu").
+func (f FocusExpr) Within(element string) FocusExpr {
+	f.expr += ".within(" + element + ")"
+	return f
+}
+
+// Wrap makes Next and Previous wrap around at the ends.
+func (f FocusExpr) Wrap() FocusExpr {
+	f.expr += ".wrap()"
+	return f
+}
+
+// Noscroll focuses without scrolling the element into view.
+func (f FocusExpr) Noscroll() FocusExpr {
+	f.expr += ".noscroll()"
+	return f
+}
+
+// First focuses the first focusable element.
+func (f FocusExpr) First() string { return f.expr + ".first()" }
+
+// Last focuses the last focusable element.
+func (f FocusExpr) Last() string { return f.expr + ".last()" }
+
+// Next focuses the next focusable element.
+func (f FocusExpr) Next() string { return f.expr + ".next()" }
+
+// Previous focuses the previous focusable element.
+func (f FocusExpr) Previous() string { return f.expr + ".previous()" }
+
+// Element focuses the given element, e.g. Element("$refs.input").
+func (f FocusExpr) Element(element string) string { return f.expr + ".focus(" + element + ")" }
@@ -0,0 +1,30 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTrapModifiers(t *testing.T) {
+	out := renderAttrs(Trap().Inert().Noscroll().Noreturn().Noautofocus().Inert().Handler("open"))
+
+	if !strings.Contains(out, `x-trap.noscroll.noreturn.noautofocus.inert="open"`) {
+		t.Errorf("unexpected attribute in %s", out)
+	}
+}
+
+func TestFocusExpressions(t *testing.T) {
+	tests := map[string]string{
+		Focus().First():                           "$focus.first()",
+		Focus().Wrap().Next():                     "$focus.wrap().next()",
+		Focus().Within("$refs.menu").Previous():   "$focus.within($refs.menu).previous()",
+		Focus().Noscroll().Element("$refs.input"): "$focus.noscroll().focus($refs.input)",
+		Focus().Wrap().Last():                     "$focus.wrap().last()",
+	}
+
+	for got, want := range tests {
+		if got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
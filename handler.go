@@ -0,0 +1,236 @@
+package alpine
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/plainkit/alpine/js"
+)
+
+// asset is an embedded script served with a content-hash ETag and
+// precomputed compressed variants.
+type asset struct {
+	body   []byte
+	brotli []byte // optional, precomputed at build time
+	hash   string
+
+	sourceMap []byte // optional, served at the script URL plus ".map"
+
+	gzipOnce sync.Once
+	gzip     []byte
+
+	sriOnce   sync.Once
+	sriValues SRI
+}
+
+func newAsset(body, brotli []byte) *asset {
+	return &asset{body: body, brotli: brotli, hash: contentHash(body)}
+}
+
+var coreAsset = newAsset(js.AlpineMinJS, js.AlpineMinJSBrotli)
+
+// contentHash returns a short hex digest identifying b.
+func contentHash(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Handler serves the embedded Alpine.js (see JavaScript) with:
+//
+//   - a strong content-hash ETag and 304 responses to If-None-Match
+//   - precomputed brotli and gzip variants chosen by Accept-Encoding
+//   - Content-Type text/javascript with a charset
+//   - Cache-Control no-cache, so browsers revalidate with the ETag and pick
+//     up a new bundle at the same URL after an upgrade
+//
+// For long-lived immutable caching, serve the fingerprinted URL from
+// AssetHandler instead.
+//
+// Options select another build, e.g. Handler(CSPBuild()) or Handler(Dev());
+// Handler panics if that build is not bundled. Mount it at any path:
+//
+//	http.Handle("/js/alpine.min.js", alpine.Handler())
+//
+// To serve a Dev source map, also route the script path plus ".map" here.
+func Handler(opts ...Option) http.Handler {
+	a, _ := mustBuildAsset(opts)
+	return a
+}
+
+// Cache-Control policies. Only fingerprinted URLs, whose content never
+// changes, may be cached as immutable.
+const (
+	cacheRevalidate = "no-cache"
+	cacheImmutable  = "public, max-age=31536000, immutable"
+)
+
+func (a *asset) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.serve(w, r, cacheRevalidate)
+}
+
+// serve writes the asset with the given Cache-Control policy.
+func (a *asset) serve(w http.ResponseWriter, r *http.Request, cacheControl string) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if strings.HasSuffix(r.URL.Path, ".map") {
+		a.serveSourceMap(w, r)
+		return
+	}
+
+	encoding, body := a.negotiate(r.Header.Get("Accept-Encoding"))
+
+	etag := `"` + a.hash + `"`
+	if encoding != "" {
+		etag = `"` + a.hash + "-" + encoding + `"`
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/javascript; charset=utf-8")
+	h.Set("Cache-Control", cacheControl)
+	h.Set("ETag", etag)
+	h.Add("Vary", "Accept-Encoding")
+
+	if a.sourceMap != nil {
+		h.Set("SourceMap", path.Base(r.URL.Path)+".map")
+	}
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if encoding != "" {
+		h.Set("Content-Encoding", encoding)
+	}
+
+	h.Set("Content-Length", strconv.Itoa(len(body)))
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	_, _ = w.Write(body)
+}
+
+// serveSourceMap answers requests for the script URL plus ".map".
+// Maps are a development aid, so they are not cached.
+func (a *asset) serveSourceMap(w http.ResponseWriter, r *http.Request) {
+	if a.sourceMap == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", cacheRevalidate)
+	h.Set("Content-Length", strconv.Itoa(len(a.sourceMap)))
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	_, _ = w.Write(a.sourceMap)
+}
+
+// negotiate picks the best encoding the client accepts: br, then gzip,
+// then the identity body.
+func (a *asset) negotiate(acceptEncoding string) (string, []byte) {
+	accepted := parseAcceptEncoding(acceptEncoding)
+
+	if a.brotli != nil && accepted["br"] {
+		return "br", a.brotli
+	}
+
+	if accepted["gzip"] {
+		return "gzip", a.gzipped()
+	}
+
+	return "", a.body
+}
+
+func (a *asset) gzipped() []byte {
+	a.gzipOnce.Do(func() {
+		var buf bytes.Buffer
+
+		zw, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+		_, _ = zw.Write(a.body)
+		_ = zw.Close()
+
+		a.gzip = buf.Bytes()
+	})
+
+	return a.gzip
+}
+
+// sri returns the integrity values of the identity body, computed once.
+func (a *asset) sri() SRI {
+	a.sriOnce.Do(func() { a.sriValues = computeSRI(a.body) })
+	return a.sriValues
+}
+
+// parseAcceptEncoding returns the codings accepted with a non-zero quality.
+// A wildcard accepts br and gzip unless they are listed explicitly.
+func parseAcceptEncoding(header string) map[string]bool {
+	accepted := map[string]bool{}
+	explicit := map[string]bool{}
+	wildcard := false
+
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+
+		ok := true
+
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				ok = false
+			}
+		}
+
+		if coding == "*" {
+			wildcard = ok
+			continue
+		}
+
+		explicit[coding] = true
+		accepted[coding] = ok
+	}
+
+	if wildcard {
+		for _, c := range []string{"br", "gzip"} {
+			if !explicit[c] {
+				accepted[c] = true
+			}
+		}
+	}
+
+	return accepted
+}
+
+// etagMatches implements the weak comparison used for If-None-Match.
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+package alpine
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func serve(h http.Handler, method string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/js/alpine.min.js", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestHandlerServesIdentity(t *testing.T) {
+	rec := serve(Handler(), http.MethodGet, nil)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+
+	if !bytes.Equal(rec.Body.Bytes(), JavaScript()) {
+		t.Error("body differs from JavaScript()")
+	}
+
+	h := rec.Header()
+	if h.Get("Content-Type") != "text/javascript; charset=utf-8" {
+		t.Errorf("Content-Type = %q", h.Get("Content-Type"))
+	}
+
+	if h.Get("Cache-Control") != "no-cache" {
+		t.Errorf("Cache-Control = %q", h.Get("Cache-Control"))
+	}
+
+	if h.Get("ETag") == "" || h.Get("Content-Encoding") != "" {
+		t.Errorf("ETag = %q, Content-Encoding = %q", h.Get("ETag"), h.Get("Content-Encoding"))
+	}
+}
+
+func TestHandlerNegotiatesEncoding(t *testing.T) {
+	tests := map[string]string{
+		"gzip, deflate, br": "br",
+		"gzip":              "gzip",
+		"br;q=0, gzip":      "gzip",
+		"*":                 "br",
+		"*, br;q=0":         "gzip",
+		"identity":          "",
+	}
+
+	for accept, want := range tests {
+		rec := serve(Handler(), http.MethodGet, map[string]string{"Accept-Encoding": accept})
+		if got := rec.Header().Get("Content-Encoding"); got != want {
+			t.Errorf("Accept-Encoding %q: got %q, want %q", accept, got, want)
+		}
+	}
+
+	rec := serve(Handler(), http.MethodGet, map[string]string{"Accept-Encoding": "gzip"})
+
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := io.ReadAll(zr)
+	if !bytes.Equal(body, JavaScript()) {
+		t.Error("gzip body does not decompress to JavaScript()")
+	}
+}
+
+func TestHandlerConditionalRequests(t *testing.T) {
+	etag := serve(Handler(), http.MethodGet, nil).Header().Get("ETag")
+
+	rec := serve(Handler(), http.MethodGet, map[string]string{"If-None-Match": `"other", ` + etag})
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("status = %d, %d bytes", rec.Code, rec.Body.Len())
+	}
+
+	// A gzip ETag does not validate the identity representation.
+	gz := serve(Handler(), http.MethodGet, map[string]string{"Accept-Encoding": "gzip"}).Header().Get("ETag")
+	if rec := serve(Handler(), http.MethodGet, map[string]string{"If-None-Match": gz}); rec.Code != http.StatusOK {
+		t.Errorf("cross-encoding ETag: status = %d", rec.Code)
+	}
+
+	if rec := serve(Handler(), http.MethodHead, nil); rec.Code != http.StatusOK || rec.Body.Len() != 0 {
+		t.Errorf("HEAD: status = %d, %d bytes", rec.Code, rec.Body.Len())
+	}
+
+	if rec := serve(Handler(), http.MethodPost, nil); rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST: status = %d", rec.Code)
+	}
+}
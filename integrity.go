@@ -0,0 +1,68 @@
+package alpine
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+
+	"github.com/plainkit/html"
+)
+
+// SRI holds Subresource Integrity values for a script, each formatted for
+// the integrity attribute ("sha384-...").
+type SRI struct {
+	SHA256 string
+	SHA384 string
+	SHA512 string
+}
+
+func computeSRI(b []byte) SRI {
+	s256 := sha256.Sum256(b)
+	s384 := sha512.Sum384(b)
+	s512 := sha512.Sum512(b)
+
+	return SRI{
+		SHA256: "sha256-" + base64.StdEncoding.EncodeToString(s256[:]),
+		SHA384: "sha384-" + base64.StdEncoding.EncodeToString(s384[:]),
+		SHA512: "sha512-" + base64.StdEncoding.EncodeToString(s512[:]),
+	}
+}
+
+// String returns the SHA-384 value, the usual choice for integrity attributes.
+func (s SRI) String() string {
+	return s.SHA384
+}
+
+// JavaScriptSRI returns the integrity values of the embedded Alpine.js.
+func JavaScriptSRI() SRI {
+	return coreAsset.sri()
+}
+
+// PluginSRI returns the integrity values of an embedded plugin, and false
+// if the plugin is not bundled.
+func PluginSRI(p Plugin) (SRI, bool) {
+	a, ok := fileAssets()[p.FileName()]
+	if !ok {
+		return SRI{}, false
+	}
+
+	return a.sri(), true
+}
+
+// MorphClientSRI returns the integrity values of the morph client.
+func MorphClientSRI() SRI {
+	return fileAssets()[morphClientFileName].sri()
+}
+
+// IntegrityScript returns a deferred <script> tag for src with integrity and
+// crossorigin="anonymous" set, e.g. to load the bundle from a CDN:
+//
+//	alpine.IntegrityScript("https://cdn.example.com/alpine.min.js", alpine.JavaScriptSRI())
+func IntegrityScript(src string, sri SRI) html.Node {
+	return html.Script(
+		html.ASrc(src),
+		html.ADefer(),
+		html.AIntegrity(sri.String()),
+		html.ACrossorigin("anonymous"),
+	)
+}
@@ -0,0 +1,47 @@
+package alpine
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestJavaScriptSRI(t *testing.T) {
+	sum := sha512.Sum384(JavaScript())
+	want := "sha384-" + base64.StdEncoding.EncodeToString(sum[:])
+
+	sri := JavaScriptSRI()
+	if sri.SHA384 != want || sri.String() != want {
+		t.Errorf("SHA384 = %q, want %q", sri.SHA384, want)
+	}
+
+	if !strings.HasPrefix(sri.SHA256, "sha256-") || !strings.HasPrefix(sri.SHA512, "sha512-") {
+		t.Errorf("unexpected SRI %+v", sri)
+	}
+
+	if MorphClientSRI() == (SRI{}) {
+		t.Error("MorphClientSRI is empty")
+	}
+}
+
+func TestIntegrityScript(t *testing.T) {
+	out := html.Render(IntegrityScript("https://cdn.example.com/alpine.min.js", JavaScriptSRI()))
+
+	for _, want := range []string{
+		` src="https://cdn.example.com/alpine.min.js"`,
+		` defer`,
+		` integrity="` + JavaScriptSRI().SHA384 + `"`,
+		` crossorigin="anonymous"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s in %s", want, out)
+		}
+	}
+
+	if !strings.Contains(html.Render(Script()), JavaScriptSRI().SHA384) {
+		t.Error("Script() has no integrity attribute")
+	}
+}
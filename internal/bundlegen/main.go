@@ -0,0 +1,59 @@
+// Command bundlegen writes attrbundle_apply.go: one method per element argument
+// interface of plainkit/html, so an alpine.Bundle is accepted wherever an
+// html.Global is. Run via `go generate` in the alpine package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/plainkit/html"
+)
+
+func main() {
+	t := reflect.TypeOf(html.Global{})
+
+	var methods []string
+
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if !strings.HasPrefix(m.Name, "Apply") || m.Type.NumIn() != 3 {
+			continue
+		}
+
+		attrs := m.Type.In(1)
+		if attrs.Kind() != reflect.Pointer || attrs.Elem().PkgPath() != t.PkgPath() {
+			continue
+		}
+
+		methods = append(methods, fmt.Sprintf(
+			"func (b Bundle) %s(a *html.%s, kids *[]html.Component) {\n\tfor _, g := range b.mustAttrs() {\n\t\tg.%s(a, kids)\n\t}\n}\n",
+			m.Name, attrs.Elem().Name(), m.Name,
+		))
+	}
+
+	sort.Strings(methods)
+
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by internal/bundlegen; DO NOT EDIT.\n\npackage alpine\n\nimport \"github.com/plainkit/html\"\n")
+
+	for _, m := range methods {
+		buf.WriteString("\n" + m)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("attrbundle_apply.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,186 @@
+package alpine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// Intersect plugin directives. They require PluginIntersect to be loaded (see Scripts).
+
+// XIntersect runs an expression whenever the element enters the viewport.
+// Example: XIntersect("shown = true")
+func XIntersect[E Expression](expression E) html.Global {
+	return directive("x-intersect", expression)
+}
+
+// XIntersectEnter is an explicit form of XIntersect.
+// Example: XIntersectEnter("loadMore()")
+func XIntersectEnter[E Expression](expression E) html.Global {
+	return directive("x-intersect:enter", expression)
+}
+
+// XIntersectLeave runs an expression when the element leaves the viewport.
+// Example: XIntersectLeave("paused = true")
+func XIntersectLeave[E Expression](expression E) html.Global {
+	return directive("x-intersect:leave", expression)
+}
+
+// Intersection builds an x-intersect directive with modifiers.
+//
+//	alpine.Intersect().Once().Margin("200px").Enter("loadMore()")
+//	// x-intersect:enter.once.margin.200px="loadMore()"
+type Intersection struct {
+	modifiers modifiers
+	err       error
+}
+
+var marginPattern = regexp.MustCompile(`^-?[0-9]+(px|%)?$`)
+
+// Intersect starts an x-intersect builder.
+func Intersect() Intersection {
+	return Intersection{}
+}
+
+// Once only runs the expression the first time.
+func (i Intersection) Once() Intersection {
+	i.modifiers = i.modifiers.with("once", "")
+	return i
+}
+
+// Half triggers when half of the element is visible.
+func (i Intersection) Half() Intersection {
+	i.modifiers = i.modifiers.with("half", "")
+	return i
+}
+
+// Full triggers when the whole element is visible.
+func (i Intersection) Full() Intersection {
+	i.modifiers = i.modifiers.with("full", "")
+	return i
+}
+
+// Threshold triggers when percent (0-100) of the element is visible.
+func (i Intersection) Threshold(percent int) Intersection {
+	if percent < 0 || percent > 100 {
+		i.err = fmt.Errorf("alpine: intersect threshold must be between 0 and 100, got %d", percent)
+		return i
+	}
+
+	// The plugin reads the value as a decimal fraction (".05"), so single
+	// digits need a leading zero; 0 and 100 are special-cased.
+	arg := fmt.Sprintf("%02d", percent)
+	if percent == 0 || percent == 100 {
+		arg = strconv.Itoa(percent)
+	}
+
+	i.modifiers = i.modifiers.with("threshold", arg)
+
+	return i
+}
+
+// Margin grows or shrinks the observed area like CSS margin: one to four
+// values, each an integer with an optional px or % unit (px by default).
+// Example: Margin("-100px", "0")
+func (i Intersection) Margin(values ...string) Intersection {
+	if len(values) == 0 || len(values) > 4 {
+		i.err = fmt.Errorf("alpine: intersect margin takes 1 to 4 values, got %d", len(values))
+		return i
+	}
+
+	for _, v := range values {
+		if !marginPattern.MatchString(v) {
+			i.err = fmt.Errorf("alpine: invalid intersect margin %q (want e.g. 200px, -10%% or 0)", v)
+			return i
+		}
+	}
+
+	i.modifiers = i.modifiers.with("margin", strings.Join(values, "."))
+
+	return i
+}
+
+// Err reports whether the modifiers are valid.
+func (i Intersection) Err() error {
+	if i.err != nil {
+		return i.err
+	}
+
+	n := 0
+
+	for _, m := range []string{"half", "full", "threshold"} {
+		if i.modifiers.has(m) {
+			n++
+		}
+	}
+
+	if n > 1 {
+		return errors.New("alpine: intersect accepts only one of .half, .full and .threshold")
+	}
+
+	return nil
+}
+
+// Handler renders x-intersect with the configured modifiers.
+// It panics if the modifiers are invalid; use Err to check first.
+func (i Intersection) Handler(expression string) html.Global {
+	return i.attr("x-intersect", expression)
+}
+
+// Enter renders x-intersect:enter with the configured modifiers.
+func (i Intersection) Enter(expression string) html.Global {
+	return i.attr("x-intersect:enter", expression)
+}
+
+// Leave renders x-intersect:leave with the configured modifiers.
+func (i Intersection) Leave(expression string) html.Global {
+	return i.attr("x-intersect:leave", expression)
+}
+
+// HandlerExpr is Handler for an expression built with package expr.
+func (i Intersection) HandlerExpr(expression expr.Expr) html.Global {
+	return i.attr("x-intersect", expression.String())
+}
+
+// EnterExpr is Enter for an expression built with package expr.
+func (i Intersection) EnterExpr(expression expr.Expr) html.Global {
+	return i.attr("x-intersect:enter", expression.String())
+}
+
+// LeaveExpr is Leave for an expression built with package expr.
+func (i Intersection) LeaveExpr(expression expr.Expr) html.Global {
+	return i.attr("x-intersect:leave", expression.String())
+}
+
+func (i Intersection) attr(name, expression string) html.Global {
+	if err := i.Err(); err != nil {
+		panic(err)
+	}
+
+	return directive(name+i.ordered().String(), expression)
+}
+
+// ordered moves .margin to the end. The plugin reads up to four modifiers
+// after .margin as its values, so anything following it, such as
+// .threshold.50, would be taken for part of the margin.
+func (i Intersection) ordered() modifiers {
+	out := make(modifiers, 0, len(i.modifiers))
+
+	var margin []modifier
+
+	for _, m := range i.modifiers {
+		if m.name == "margin" {
+			margin = append(margin, m)
+			continue
+		}
+
+		out = append(out, m)
+	}
+
+	return append(out, margin...)
+}
@@ -0,0 +1,56 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIntersectModifiers(t *testing.T) {
+	out := renderAttrs(
+		XIntersect("shown = true"),
+		Intersect().Once().Margin("200px").Enter("loadMore()"),
+		Intersect().Threshold(5).Leave("paused = true"),
+		Intersect().Half().Margin("-10%", "0").Handler("seen()"),
+	)
+
+	for _, want := range []string{
+		`x-intersect="shown = true"`,
+		`x-intersect:enter.once.margin.200px="loadMore()"`,
+		`x-intersect:leave.threshold.05="paused = true"`,
+		`x-intersect.half.margin.-10%.0="seen()"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s in %s", want, out)
+		}
+	}
+}
+
+func TestIntersectMarginLast(t *testing.T) {
+	out := renderAttrs(Intersect().Margin("10px").Threshold(50).Once().Enter("seen()"))
+
+	if want := `x-intersect:enter.threshold.50.once.margin.10px="seen()"`; !strings.Contains(out, want) {
+		t.Errorf("got %s, want %s", out, want)
+	}
+}
+
+func TestIntersectValidation(t *testing.T) {
+	invalid := []Intersection{
+		Intersect().Threshold(101),
+		Intersect().Threshold(-1),
+		Intersect().Margin("10em"),
+		Intersect().Margin(),
+		Intersect().Margin("1px", "2px", "3px", "4px", "5px"),
+		Intersect().Half().Full(),
+		Intersect().Full().Threshold(20),
+	}
+
+	for _, i := range invalid {
+		if i.Err() == nil {
+			t.Errorf("%s: expected error", i.modifiers.String())
+		}
+	}
+
+	if err := Intersect().Threshold(100).Once().Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,17 @@
+#!/bin/sh
+# Writes brotli-compressed copies of the embedded bundles, served by
+# alpine.Handler to clients that accept br. Run via `go generate ./js`;
+# other files can be passed as arguments, relative to this directory.
+set -eu
+
+cd "$(dirname "$0")"
+
+for f in "${@:-v3_15/alpine.min.js}"; do
+	node -e '
+		const fs = require("fs"), zlib = require("zlib");
+		const src = process.argv[1];
+		fs.writeFileSync(src + ".br", zlib.brotliCompressSync(fs.readFileSync(src), {
+			params: { [zlib.constants.BROTLI_PARAM_QUALITY]: 11 },
+		}));
+	' "$f"
+done
@@ -0,0 +1,35 @@
+//go:build alpine_v3_14
+
+package js
+
+import "github.com/plainkit/alpine/js/v3_14"
+
+// Version is the release of AlpineMinJS.
+const Version = v3_14.Version
+
+// AlpineMinJS contains the minified Alpine.js JavaScript library.
+// This can be served directly or embedded in HTML pages.
+var AlpineMinJS = v3_14.AlpineMinJS
+
+// AlpineMinJSBrotli is AlpineMinJS compressed with brotli at quality 11.
+var AlpineMinJSBrotli = v3_14.AlpineMinJSBrotli
+
+// Plugin returns the minified build of the named official plugin
+// (e.g. "focus" for @alpinejs/focus) from the same release as AlpineMinJS,
+// or nil if it has not been vendored.
+func Plugin(name string) []byte {
+	return v3_14.Plugin(name)
+}
+
+// Bundle returns an alternative build of Alpine.js by file name
+// (e.g. "alpine-csp.min.js") from the same release as AlpineMinJS, or nil
+// if it has not been vendored.
+func Bundle(name string) []byte {
+	return v3_14.Bundle(name)
+}
+
+func init() {
+	if AlpineMinJS == nil {
+		panic("js: Alpine.js " + Version + " is not vendored; run go generate ./js/v3_14")
+	}
+}
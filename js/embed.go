@@ -2,8 +2,10 @@ package js
 
 import _ "embed"
 
-// AlpineMinJS contains the minified Alpine.js JavaScript library (v3.x).
-// This can be served directly or embedded in HTML pages.
+//go:generate go generate ./v3_15
+
+// MorphClientJS contains the client used by alpine.MorphHandler: it registers
+// a $morph(url) magic that fetches a fragment and applies it with Alpine.morph.
 //
-//go:embed alpine.min.js
-var AlpineMinJS []byte
+//go:embed morph-client.js
+var MorphClientJS []byte
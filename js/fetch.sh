@@ -0,0 +1,22 @@
+#!/bin/sh
+# Usage: fetch.sh VERSION
+#
+# Downloads the official Alpine.js plugin builds into plugins/ and the
+# alternative core builds into bundles/ of the current directory, pinned to
+# VERSION. Each release package (v3_15, v3_14) runs it via go generate, so
+# plugins always match the core they are served with.
+set -eu
+
+VERSION=$1
+CDN=https://cdn.jsdelivr.net/npm
+
+for plugin in mask intersect persist focus collapse morph anchor sort resize; do
+	curl -fsSL "${CDN}/@alpinejs/${plugin}@${VERSION}/dist/cdn.min.js" -o "plugins/${plugin}.min.js"
+done
+
+curl -fsSL "${CDN}/@alpinejs/csp@${VERSION}/dist/cdn.min.js" -o bundles/alpine-csp.min.js
+
+# Unminified builds for alpine.Dev. Alpine does not publish source maps; one
+# vendored as bundles/<name>.map is served alongside its build.
+curl -fsSL "${CDN}/alpinejs@${VERSION}/dist/cdn.js" -o bundles/alpine.js
+curl -fsSL "${CDN}/@alpinejs/csp@${VERSION}/dist/cdn.js" -o bundles/alpine-csp.js
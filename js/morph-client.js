@@ -0,0 +1,23 @@
+// Client for alpine.MorphHandler: fetches a server-rendered fragment and
+// morphs it into the page with Alpine.morph, keeping Alpine state intact.
+// Requires the Morph plugin. Load before Alpine, like any plugin.
+document.addEventListener('alpine:init', () => {
+    const morph = async (url, options = {}) => {
+        const response = await fetch(url, {
+            ...options,
+            headers: { ...(options.headers || {}), 'X-Alpine-Morph': 'true' },
+        })
+
+        if (! response.ok) throw new Error(`alpine morph: ${response.status} from ${url}`)
+
+        const selector = options.target || response.headers.get('X-Alpine-Target')
+        const target = selector && document.querySelector(selector)
+
+        if (! target) throw new Error(`alpine morph: no element matches "${selector}"`)
+
+        Alpine.morph(target, await response.text())
+    }
+
+    window.alpineMorph = morph
+    Alpine.magic('morph', () => morph)
+})
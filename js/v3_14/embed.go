@@ -0,0 +1,57 @@
+// Package v3_14 embeds the Alpine.js 3.14 release with its plugins and
+// alternative builds, for services that have not moved to 3.15 yet. Build
+// with -tags alpine_v3_14 to serve it through the alpine helpers instead of
+// the default release.
+//
+// The files are vendored by `go generate ./js/v3_14`.
+package v3_14
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:generate ./fetch.sh
+
+// Version is the embedded release.
+const Version = "3.14.9"
+
+//go:embed dist
+var dist embed.FS
+
+// AlpineMinJS contains the minified Alpine.js library, or nil if it has not
+// been vendored into dist.
+var AlpineMinJS = read(dist, "dist/alpine.min.js")
+
+// AlpineMinJSBrotli is AlpineMinJS compressed with brotli, or nil if it has
+// not been vendored.
+var AlpineMinJSBrotli = read(dist, "dist/alpine.min.js.br")
+
+//go:embed plugins
+var plugins embed.FS
+
+//go:embed bundles
+var bundles embed.FS
+
+// Plugin returns the minified build of the named official plugin
+// (e.g. "focus" for @alpinejs/focus), or nil if it has not been vendored
+// into the plugins directory.
+func Plugin(name string) []byte {
+	return read(plugins, "plugins/"+name+".min.js")
+}
+
+// Bundle returns an alternative build of Alpine.js by file name
+// (e.g. "alpine-csp.min.js"), or nil if it has not been vendored into the
+// bundles directory.
+func Bundle(name string) []byte {
+	return read(bundles, "bundles/"+name)
+}
+
+func read(fsys fs.FS, name string) []byte {
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
@@ -0,0 +1,13 @@
+#!/bin/sh
+# Downloads the pinned Alpine.js 3.14 build into dist/ with its brotli copy,
+# and the matching plugins and alternative builds. Run via
+# `go generate ./js/v3_14`.
+set -eu
+
+VERSION=3.14.9
+
+cd "$(dirname "$0")"
+
+curl -fsSL "https://cdn.jsdelivr.net/npm/alpinejs@${VERSION}/dist/cdn.min.js" -o dist/alpine.min.js
+../compress.sh v3_14/dist/alpine.min.js
+../fetch.sh "$VERSION"
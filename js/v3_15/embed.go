@@ -0,0 +1,53 @@
+// Package v3_15 embeds the Alpine.js 3.15 release with its plugins and
+// alternative builds. It is the default release behind package js.
+package v3_15
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:generate ../fetch.sh 3.15.0
+//go:generate ../compress.sh
+
+// Version is the embedded release.
+const Version = "3.15.0"
+
+// AlpineMinJS contains the minified Alpine.js library.
+//
+//go:embed alpine.min.js
+var AlpineMinJS []byte
+
+// AlpineMinJSBrotli is AlpineMinJS compressed with brotli at quality 11.
+//
+//go:embed alpine.min.js.br
+var AlpineMinJSBrotli []byte
+
+//go:embed plugins
+var plugins embed.FS
+
+//go:embed bundles
+var bundles embed.FS
+
+// Plugin returns the minified build of the named official plugin
+// (e.g. "focus" for @alpinejs/focus), or nil if it has not been vendored
+// into the plugins directory.
+func Plugin(name string) []byte {
+	return read(plugins, "plugins/"+name+".min.js")
+}
+
+// Bundle returns an alternative build of Alpine.js by file name
+// (e.g. "alpine-csp.min.js"), or nil if it has not been vendored into the
+// bundles directory.
+func Bundle(name string) []byte {
+	return read(bundles, "bundles/"+name)
+}
+
+func read(fsys fs.FS, name string) []byte {
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
@@ -0,0 +1,248 @@
+package alpine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Key is a keyboard modifier as Alpine matches it: a kebab-cased
+// KeyboardEvent.key value or one of Alpine's aliases.
+type Key string
+
+// System modifiers. Combined with another key they require the modifier
+// to be held down; on mouse events they may be used on their own.
+const (
+	KeyCtrl  Key = "ctrl"
+	KeyShift Key = "shift"
+	KeyAlt   Key = "alt"
+	KeyMeta  Key = "meta"
+	KeyCmd   Key = "cmd"
+	KeySuper Key = "super"
+)
+
+// Named keys.
+const (
+	KeyEnter      Key = "enter"
+	KeySpace      Key = "space"
+	KeyTab        Key = "tab"
+	KeyEscape     Key = "escape"
+	KeyBackspace  Key = "backspace"
+	KeyDelete     Key = "delete"
+	KeyCapsLock   Key = "caps-lock"
+	KeyArrowUp    Key = "arrow-up"
+	KeyArrowDown  Key = "arrow-down"
+	KeyArrowLeft  Key = "arrow-left"
+	KeyArrowRight Key = "arrow-right"
+	KeyPageUp     Key = "page-up"
+	KeyPageDown   Key = "page-down"
+	KeyHome       Key = "home"
+	KeyEnd        Key = "end"
+	KeySlash      Key = "slash"
+	KeyPeriod     Key = "period"
+	KeyComma      Key = "comma"
+	KeyEqual      Key = "equal"
+	KeyMinus      Key = "minus"
+	KeyUnderscore Key = "underscore"
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// keyAliases maps KeyboardEvent.key values that cannot appear in an
+// attribute name to the alias Alpine uses for them.
+var keyAliases = map[string]Key{
+	" ": KeySpace,
+	"/": KeySlash,
+	".": KeyPeriod,
+	",": KeyComma,
+	"=": KeyEqual,
+	"-": KeyMinus,
+	"_": KeyUnderscore,
+}
+
+// KeyOf converts a KeyboardEvent.key value such as "PageDown" or "/" to
+// the modifier Alpine matches it with ("page-down", "slash").
+func KeyOf(eventKey string) Key {
+	if k, ok := keyAliases[eventKey]; ok {
+		return k
+	}
+
+	var sb strings.Builder
+
+	prev := rune(0)
+	for _, r := range eventKey {
+		switch {
+		case unicode.IsUpper(r) && unicode.IsLower(prev):
+			sb.WriteByte('-')
+			sb.WriteRune(unicode.ToLower(r))
+		case r == '_' || unicode.IsSpace(r):
+			sb.WriteByte('-')
+		default:
+			sb.WriteRune(unicode.ToLower(r))
+		}
+
+		prev = r
+	}
+
+	return Key(sb.String())
+}
+
+// IsSystem reports whether k is a system modifier (ctrl, shift, alt, meta, cmd, super).
+func (k Key) IsSystem() bool {
+	switch k {
+	case KeyCtrl, KeyShift, KeyAlt, KeyMeta, KeyCmd, KeySuper:
+		return true
+	}
+
+	return false
+}
+
+// Err reports whether Alpine can match k.
+func (k Key) Err() error {
+	if !keyPattern.MatchString(string(k)) {
+		return fmt.Errorf("alpine: key %q is not a kebab-cased key name (use KeyOf to convert KeyboardEvent.key values)", string(k))
+	}
+
+	return nil
+}
+
+// KeyCombo is a keyboard shortcut: system modifiers followed by at most one key.
+type KeyCombo []Key
+
+// ParseKeyCombo parses a shortcut written as "ctrl+shift+k" or "meta+Enter".
+// Each part is converted with KeyOf.
+func ParseKeyCombo(s string) (KeyCombo, error) {
+	if s == "" {
+		return nil, errors.New("alpine: empty key combination")
+	}
+
+	parts := strings.Split(s, "+")
+	combo := make(KeyCombo, 0, len(parts))
+
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("alpine: empty key in combination %q", s)
+		}
+
+		combo = append(combo, KeyOf(p))
+	}
+
+	if err := combo.Err(); err != nil {
+		return nil, err
+	}
+
+	return combo, nil
+}
+
+// String returns the modifier form, e.g. "ctrl.shift.k".
+func (c KeyCombo) String() string {
+	parts := make([]string, len(c))
+	for i, k := range c {
+		parts[i] = string(k)
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// Err reports whether Alpine can match c on a keydown or keyup event.
+func (c KeyCombo) Err() error {
+	if len(c) == 0 {
+		return errors.New("alpine: empty key combination")
+	}
+
+	var system, keys int
+
+	for _, k := range c {
+		if err := k.Err(); err != nil {
+			return err
+		}
+
+		if k.IsSystem() {
+			system++
+		} else {
+			keys++
+		}
+	}
+
+	if keys > 1 {
+		return fmt.Errorf("alpine: key combination %q has more than one non-modifier key", c.String())
+	}
+
+	if keys == 0 && system > 1 {
+		return fmt.Errorf("alpine: key combination %q needs a non-modifier key", c.String())
+	}
+
+	return nil
+}
+
+// Keys restricts the listener to a key combination, rendered right after
+// the event name: On("keydown").Keys(KeyCtrl, Key("k")) produces keydown.ctrl.k.
+// Key events accept any valid combination; click, contextmenu and mouse
+// events accept system modifiers only.
+func (e Event) Keys(keys ...Key) Event {
+	combo := make(KeyCombo, 0, len(e.keys)+len(keys))
+	combo = append(combo, e.keys...)
+
+	for _, k := range keys {
+		if !combo.has(k) {
+			combo = append(combo, k)
+		}
+	}
+
+	// System modifiers first, so the combo reads the way it is typed.
+	sorted := make(KeyCombo, 0, len(combo))
+	for _, k := range combo {
+		if k.IsSystem() {
+			sorted = append(sorted, k)
+		}
+	}
+
+	for _, k := range combo {
+		if !k.IsSystem() {
+			sorted = append(sorted, k)
+		}
+	}
+
+	e.keys = sorted
+
+	return e
+}
+
+func (c KeyCombo) has(k Key) bool {
+	for _, x := range c {
+		if x == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keysErr validates e.keys against the event type, mirroring which events
+// Alpine applies its key filter to.
+func (e Event) keysErr() error {
+	if len(e.keys) == 0 {
+		return nil
+	}
+
+	if e.has("camel") || e.has("dot") {
+		return fmt.Errorf("alpine: key modifiers cannot be combined with .camel or .dot on %q", e.name)
+	}
+
+	switch {
+	case e.name == "keydown" || e.name == "keyup":
+		return e.keys.Err()
+	case e.name == "contextmenu" || strings.Contains(e.name, "click") || strings.Contains(e.name, "mouse"):
+		for _, k := range e.keys {
+			if !k.IsSystem() {
+				return fmt.Errorf("alpine: %q events only accept system key modifiers, got %q", e.name, string(k))
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("alpine: %q events do not support key modifiers", e.name)
+	}
+}
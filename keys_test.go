@@ -0,0 +1,80 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKeyOf(t *testing.T) {
+	tests := map[string]Key{
+		"PageDown":   KeyPageDown,
+		"ArrowUp":    KeyArrowUp,
+		"K":          "k",
+		"/":          KeySlash,
+		" ":          KeySpace,
+		"CapsLock":   KeyCapsLock,
+		"Escape":     KeyEscape,
+		"MediaPause": "media-pause",
+	}
+
+	for in, want := range tests {
+		if got := KeyOf(in); got != want {
+			t.Errorf("KeyOf(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestParseKeyCombo(t *testing.T) {
+	combo, err := ParseKeyCombo("ctrl+shift+K")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := combo.String(); got != "ctrl.shift.k" {
+		t.Errorf("String() = %q", got)
+	}
+
+	for _, bad := range []string{"", "ctrl+", "a+b", "ctrl+shift", "ctrl+ü"} {
+		if _, err := ParseKeyCombo(bad); err == nil {
+			t.Errorf("ParseKeyCombo(%q): expected error", bad)
+		}
+	}
+}
+
+func TestEventKeys(t *testing.T) {
+	e := On("keydown").Keys(Key("k"), KeyCtrl, KeyShift).Prevent()
+	if err := e.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	out := renderAttrs(e.At("open()"))
+	if !strings.Contains(out, `@keydown.ctrl.shift.k.prevent="open()"`) {
+		t.Errorf("unexpected attribute in %s", out)
+	}
+
+	valid := []Event{
+		On("keyup").Keys(KeyMeta, KeyEnter),
+		On("keydown").Keys(KeyShift),
+		On("click").Keys(KeyShift, KeyAlt),
+	}
+
+	for _, e := range valid {
+		if err := e.Err(); err != nil {
+			t.Errorf("%s: unexpected error: %v", e.Name(), err)
+		}
+	}
+
+	invalid := []Event{
+		On("keydown").Keys(Key("PageDown")),
+		On("keydown").Keys(KeyEnter, KeyTab),
+		On("click").Keys(KeyEnter),
+		On("input").Keys(KeyEnter),
+		On("keydown").Keys(KeyEnter).Camel(),
+	}
+
+	for _, e := range invalid {
+		if e.Err() == nil {
+			t.Errorf("%s: expected error", e.Name())
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package alpine
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/plainkit/html"
+)
+
+// Mask plugin directives. They require PluginMask to be loaded (see Scripts).
+
+// ValidateMask reports whether pattern is a usable x-mask pattern.
+// Patterns use 9 for digits, a for letters and * for any character;
+// everything else is inserted literally. ValidateMask rejects patterns
+// without any placeholder, the # placeholder from other mask libraries,
+// letters other than a (such as A, X or d, which Alpine would insert
+// literally) and control characters. Other digits are accepted as
+// literals, as in "+1 (999) 999-9999", so a 0 meant as a placeholder is
+// not caught.
+func ValidateMask(pattern string) error {
+	if pattern == "" {
+		return errors.New("alpine: mask pattern is empty")
+	}
+
+	for _, r := range pattern {
+		switch {
+		case r == '#':
+			return fmt.Errorf("alpine: mask pattern %q uses #; Alpine masks use 9 for digits", pattern)
+		case unicode.IsLetter(r) && r != 'a':
+			return fmt.Errorf("alpine: mask pattern %q contains %q; Alpine masks use a for letters", pattern, r)
+		case unicode.IsControl(r):
+			return fmt.Errorf("alpine: mask pattern %q contains control character %q", pattern, r)
+		}
+	}
+
+	if !strings.ContainsAny(pattern, "9a*") {
+		return fmt.Errorf("alpine: mask pattern %q has no 9, a or * placeholder", pattern)
+	}
+
+	return nil
+}
+
+// XMask formats input as the user types.
+// It panics if the pattern is invalid; see ValidateMask.
+// Example: XMask("99/99/9999")
+func XMask(pattern string) html.Global {
+	if err := ValidateMask(pattern); err != nil {
+		panic(err)
+	}
+
+	return html.ACustom("x-mask", pattern)
+}
+
+// XMaskDynamic computes the mask from an expression, which receives $input.
+// Example: XMaskDynamic("$input.startsWith('34') ? '9999 999999 99999' : '9999 9999 9999 9999'")
+func XMaskDynamic[E Expression](expression E) html.Global {
+	return directive("x-mask:dynamic", expression)
+}
+
+// MoneyMask builds a $money dynamic mask.
+type MoneyMask struct {
+	decimal   string
+	thousands string
+	precision int
+}
+
+// Money returns a money mask with Alpine's defaults: "." for decimals,
+// "," for thousands and a precision of 2.
+// Example: Money().Decimal(",").Thousands(".").XMask()
+func Money() MoneyMask {
+	return MoneyMask{decimal: ".", thousands: ",", precision: 2}
+}
+
+// Decimal sets the decimal separator.
+func (m MoneyMask) Decimal(sep string) MoneyMask {
+	m.decimal = sep
+	return m
+}
+
+// Thousands sets the thousands separator.
+func (m MoneyMask) Thousands(sep string) MoneyMask {
+	m.thousands = sep
+	return m
+}
+
+// Precision sets the number of decimal places.
+func (m MoneyMask) Precision(digits int) MoneyMask {
+	m.precision = digits
+	return m
+}
+
+// Err reports whether the separators and precision are usable.
+func (m MoneyMask) Err() error {
+	for _, sep := range []string{m.decimal, m.thousands} {
+		if utf8.RuneCountInString(sep) != 1 || strings.ContainsAny(sep, "0123456789'\\") {
+			return fmt.Errorf("alpine: money separator %q must be a single non-digit character", sep)
+		}
+	}
+
+	if m.decimal == m.thousands {
+		return fmt.Errorf("alpine: money decimal and thousands separators are both %q", m.decimal)
+	}
+
+	if m.precision < 0 {
+		return fmt.Errorf("alpine: money precision must not be negative, got %d", m.precision)
+	}
+
+	return nil
+}
+
+// Expression returns the $money call, e.g. "$money($input, ',', '.', 2)".
+func (m MoneyMask) Expression() string {
+	return "$money($input, '" + m.decimal + "', '" + m.thousands + "', " + strconv.Itoa(m.precision) + ")"
+}
+
+// XMask renders the mask as x-mask:dynamic.
+// It panics if the mask is invalid; use Err to check first.
+func (m MoneyMask) XMask() html.Global {
+	if err := m.Err(); err != nil {
+		panic(err)
+	}
+
+	return XMaskDynamic(m.Expression())
+}
@@ -0,0 +1,64 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestValidateMask(t *testing.T) {
+	for _, ok := range []string{"99/99/9999", "(999) 999-9999", "aaa-***", "+1 999 999 9999"} {
+		if err := ValidateMask(ok); err != nil {
+			t.Errorf("ValidateMask(%q): %v", ok, err)
+		}
+	}
+
+	for _, bad := range []string{"", "##/##", "--/--", "AA-999", "XX/XX", "99\t99"} {
+		if ValidateMask(bad) == nil {
+			t.Errorf("ValidateMask(%q): expected error", bad)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("XMask did not panic on invalid pattern")
+		}
+	}()
+
+	XMask("###")
+}
+
+func TestMoneyMask(t *testing.T) {
+	if got := Money().Expression(); got != "$money($input, '.', ',', 2)" {
+		t.Errorf("default = %q", got)
+	}
+
+	out := renderAttrs(Money().Decimal(",").Thousands(".").Precision(0).XMask())
+	if !strings.Contains(out, `x-mask:dynamic="$money($input, &#39;,&#39;, &#39;.&#39;, 0)"`) {
+		t.Errorf("unexpected attribute in %s", out)
+	}
+
+	invalid := []MoneyMask{
+		Money().Decimal(""),
+		Money().Thousands("1"),
+		Money().Decimal("'"),
+		Money().Decimal(","),
+		Money().Precision(-1),
+	}
+
+	for _, m := range invalid {
+		if m.Err() == nil {
+			t.Errorf("%s: expected error", m.Expression())
+		}
+	}
+}
+
+func TestMaskPlugin(t *testing.T) {
+	requirePlugins(t, PluginMask)
+
+	out := html.Render(html.Head(Scripts("/js", PluginMask)))
+	if !strings.Contains(out, `src="/js/mask.min.js"`) {
+		t.Errorf("mask plugin not loaded: %s", out)
+	}
+}
@@ -0,0 +1,54 @@
+package alpine
+
+import "strings"
+
+// modifier is a directive modifier such as .prevent or .debounce.300ms;
+// arg holds any dot-separated values that follow the name.
+type modifier struct {
+	name string
+	arg  string
+}
+
+// modifiers is an ordered modifier list shared by the directive builders.
+type modifiers []modifier
+
+// with returns a copy of ms with the modifier set, replacing an earlier
+// occurrence so repeated calls are idempotent.
+func (ms modifiers) with(name, arg string) modifiers {
+	out := make(modifiers, 0, len(ms)+1)
+
+	for _, m := range ms {
+		if m.name != name {
+			out = append(out, m)
+		}
+	}
+
+	return append(out, modifier{name: name, arg: arg})
+}
+
+func (ms modifiers) has(name string) bool {
+	for _, m := range ms {
+		if m.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String renders the modifiers with a leading dot, e.g. ".once.threshold.50".
+func (ms modifiers) String() string {
+	var sb strings.Builder
+
+	for _, m := range ms {
+		sb.WriteString(".")
+		sb.WriteString(m.name)
+
+		if m.arg != "" {
+			sb.WriteString(".")
+			sb.WriteString(m.arg)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,67 @@
+package alpine
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/plainkit/alpine/js"
+	"github.com/plainkit/html"
+)
+
+// Headers exchanged between MorphHandler and the morph client.
+const (
+	// MorphRequestHeader is set to "true" on requests made by the client.
+	MorphRequestHeader = "X-Alpine-Morph"
+	// MorphTargetHeader carries the CSS selector of the element to morph.
+	MorphTargetHeader = "X-Alpine-Target"
+)
+
+// morphClientFileName is the name the morph client is served under.
+const morphClientFileName = "morph-client.js"
+
+// MorphClientJavaScript returns the client for MorphHandler. It registers a
+// $morph(url, options) magic that fetches a fragment and morphs it into the
+// element named by the response's target header (or options.target).
+// Scripts includes it automatically when PluginMorph is requested.
+func MorphClientJavaScript() []byte {
+	return js.MorphClientJS
+}
+
+// MorphHandler serves a server-rendered fragment for the morph client.
+// render must return the target element itself, e.g. the <ul id="todos">
+// matched by target "#todos", so Alpine.morph can patch it in place.
+// If render fails, the client gets a bare 500 without the error text; log
+// the error in render if it needs recording.
+//
+//	http.Handle("/todos", alpine.MorphHandler("#todos", func(r *http.Request) (html.Node, error) {
+//		return TodoList(load(r)), nil
+//	}))
+//
+//	html.Button(alpine.AtClick(alpine.MorphFrom("/todos")), html.Text("Refresh"))
+func MorphHandler(target string, render func(r *http.Request) (html.Node, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		node, err := render(r)
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set(MorphTargetHeader, target)
+		w.Header().Add("Vary", MorphRequestHeader)
+		_, _ = w.Write([]byte(html.Render(node)))
+	})
+}
+
+// IsMorphRequest reports whether r was made by the morph client, so one
+// handler can serve either a full page or just the fragment.
+func IsMorphRequest(r *http.Request) bool {
+	return r.Header.Get(MorphRequestHeader) == "true"
+}
+
+// MorphFrom returns a handler expression that morphs the fragment served at url.
+// Example: AtClick(MorphFrom("/todos")) produces @click="$morph(&#34;/todos&#34;)"
+func MorphFrom(url string) string {
+	quoted, _ := json.Marshal(url)
+	return "$morph(" + string(quoted) + ")"
+}
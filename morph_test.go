@@ -0,0 +1,68 @@
+package alpine
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestMorphHandler(t *testing.T) {
+	h := MorphHandler("#todos", func(r *http.Request) (html.Node, error) {
+		if !IsMorphRequest(r) {
+			t.Error("IsMorphRequest = false")
+		}
+
+		return html.Ul(html.AId("todos"), html.Li(html.Text("Milk"))), nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/todos", nil)
+	req.Header.Set(MorphRequestHeader, "true")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(MorphTargetHeader); got != "#todos" {
+		t.Errorf("target header = %q", got)
+	}
+
+	if got := rec.Body.String(); got != `<ul id="todos"><li>Milk</li></ul>` {
+		t.Errorf("body = %s", got)
+	}
+
+	failing := MorphHandler("#todos", func(*http.Request) (html.Node, error) {
+		return html.Node{}, errors.New("boom")
+	})
+
+	rec = httptest.NewRecorder()
+	failing.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/todos", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d", rec.Code)
+	}
+
+	if strings.Contains(rec.Body.String(), "boom") {
+		t.Errorf("error leaked into the response: %s", rec.Body.String())
+	}
+}
+
+func TestMorphClient(t *testing.T) {
+	client := string(MorphClientJavaScript())
+	if !strings.Contains(client, MorphTargetHeader) || !strings.Contains(client, "Alpine.morph") {
+		t.Error("morph client does not implement the header protocol")
+	}
+
+	if got := MorphFrom("/todos"); got != `$morph("/todos")` {
+		t.Errorf("MorphFrom = %s", got)
+	}
+
+	requirePlugins(t, PluginMorph)
+
+	out := html.Render(html.Head(Scripts("/js", PluginMorph)))
+	if strings.Index(out, `src="/js/morph.min.js"`) > strings.Index(out, `src="/js/morph-client.js"`) {
+		t.Errorf("unexpected scripts: %s", out)
+	}
+}
@@ -0,0 +1,72 @@
+package alpine
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+type nonceKey struct{}
+
+// WithNonce returns a context carrying the per-request CSP nonce used by
+// script-producing helpers such as Registry.ScriptContext.
+func WithNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, nonceKey{}, nonce)
+}
+
+// Nonce returns the nonce stored by WithNonce, or "" if there is none.
+func Nonce(ctx context.Context) string {
+	nonce, _ := ctx.Value(nonceKey{}).(string)
+	return nonce
+}
+
+// NewNonce returns a random base64 nonce suitable for WithNonce.
+func NewNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// ScriptHash returns the CSP source expression ('sha256-...') allowing an
+// inline script with exactly this content.
+func ScriptHash(script string) string {
+	sum := sha256.Sum256([]byte(script))
+	return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+}
+
+// CSPHeader returns a Content-Security-Policy value whose script-src allows
+// same-origin scripts, inline scripts carrying nonce, and the given static
+// inline scripts by hash. build is the build option passed to Handler or
+// Script: 'unsafe-eval' is included unless it is CSPBuild(), because the
+// standard build (build == nil) requires it.
+//
+//	nonce, _ := alpine.NewNonce()
+//	w.Header().Set("Content-Security-Policy", alpine.CSPHeader(nonce, alpine.CSPBuild()))
+//	ctx := alpine.WithNonce(r.Context(), nonce)
+func CSPHeader(nonce string, build Option, staticScripts ...string) string {
+	var c buildConfig
+	if build != nil {
+		build(&c)
+	}
+
+	sources := []string{"script-src", "'self'"}
+
+	if nonce != "" {
+		sources = append(sources, "'nonce-"+nonce+"'")
+	}
+
+	for _, s := range staticScripts {
+		sources = append(sources, ScriptHash(s))
+	}
+
+	if !c.csp {
+		sources = append(sources, "'unsafe-eval'")
+	}
+
+	return strings.Join(sources, " ")
+}
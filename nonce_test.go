@@ -0,0 +1,64 @@
+package alpine
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestNonceContext(t *testing.T) {
+	if Nonce(context.Background()) != "" {
+		t.Error("expected empty nonce")
+	}
+
+	nonce, err := NewNonce()
+	if err != nil || len(nonce) < 16 {
+		t.Fatalf("NewNonce() = %q, %v", nonce, err)
+	}
+
+	ctx := WithNonce(context.Background(), nonce)
+
+	reg := NewRegistry()
+	if err := reg.Register(Store("open", false)); err != nil {
+		t.Fatal(err)
+	}
+
+	script, err := reg.ScriptContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out := html.Render(script); !strings.Contains(out, `<script nonce="`+nonce+`">`) {
+		t.Errorf("missing nonce in %s", out)
+	}
+
+	script, _ = reg.Script()
+	if out := html.Render(script); strings.Contains(out, "nonce") {
+		t.Errorf("unexpected nonce in %s", out)
+	}
+}
+
+func TestCSPHeader(t *testing.T) {
+	inline := "console.log('hi')"
+
+	got := CSPHeader("abc", nil, inline)
+	want := "script-src 'self' 'nonce-abc' " + ScriptHash(inline) + " 'unsafe-eval'"
+
+	if got != want {
+		t.Errorf("CSPHeader = %q, want %q", got, want)
+	}
+
+	if !strings.HasPrefix(ScriptHash(inline), "'sha256-") {
+		t.Errorf("ScriptHash = %q", ScriptHash(inline))
+	}
+
+	if got := CSPHeader("", CSPBuild()); got != "script-src 'self'" {
+		t.Errorf("CSP build: CSPHeader = %q", got)
+	}
+
+	if got := CSPHeader("", Dev()); got != "script-src 'self' 'unsafe-eval'" {
+		t.Errorf("Dev build: CSPHeader = %q", got)
+	}
+}
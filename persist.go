@@ -0,0 +1,190 @@
+package alpine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/plainkit/html"
+)
+
+// Persist plugin support. Persisted fields require PluginPersist to be loaded (see Scripts).
+
+// Storage names the global Storage object a persisted field is kept in.
+// A custom storage, such as one backed by cookies, is the name of a global
+// JavaScript object with getItem and setItem: Storage("cookieStorage").
+type Storage string
+
+// Storage backends for persisted fields.
+const (
+	LocalStorage   Storage = "localStorage"
+	SessionStorage Storage = "sessionStorage"
+)
+
+// DataObject is component data whose top-level fields can be persisted
+// across page loads with $persist. It can be used anywhere a data value is
+// accepted: XDataOf, JSObject, Component and Store.
+//
+//	alpine.Data(Prefs{Theme: "light"}).
+//		Persist("theme", alpine.PersistAs("prefs-theme")).
+//		XData()
+//	// x-data="{"theme":$persist("light").as("prefs-theme")}"
+type DataObject struct {
+	value   any
+	persist map[string]persistField
+}
+
+type persistField struct {
+	key     string
+	storage Storage
+}
+
+// PersistOption configures a persisted field.
+type PersistOption func(*persistField)
+
+// PersistAs stores the field under key instead of Alpine's default "_x_<field>".
+func PersistAs(key string) PersistOption {
+	return func(f *persistField) { f.key = key }
+}
+
+// PersistUsing stores the field in another Storage object, such as SessionStorage.
+// Rendering the data fails if storage is not a JavaScript identifier.
+func PersistUsing(storage Storage) PersistOption {
+	return func(f *persistField) { f.storage = storage }
+}
+
+// Data wraps v, which must serialize to a JSON object, so fields can be persisted.
+func Data(v any) DataObject {
+	return DataObject{value: v}
+}
+
+// Persist marks a top-level field, named by its JSON key, as persisted.
+func (d DataObject) Persist(field string, opts ...PersistOption) DataObject {
+	f := persistField{}
+	for _, opt := range opts {
+		opt(&f)
+	}
+
+	persist := make(map[string]persistField, len(d.persist)+1)
+	for k, v := range d.persist {
+		persist[k] = v
+	}
+
+	persist[field] = f
+	d.persist = persist
+
+	return d
+}
+
+// PersistedKeys returns the storage keys the data persists to, sorted.
+// It is mostly useful in tests; see also alpinetest.AssertPersists.
+func (d DataObject) PersistedKeys() []string {
+	keys := make([]string, 0, len(d.persist))
+
+	for field, f := range d.persist {
+		if f.key != "" {
+			keys = append(keys, f.key)
+		} else {
+			keys = append(keys, "_x_"+field)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// XData declares a component with this data.
+// It panics if the data cannot be serialized; see JSObject.
+func (d DataObject) XData(members ...string) html.Global {
+	return XDataOf(d, members...)
+}
+
+// MarshalJSON encodes the underlying value without persistence.
+func (d DataObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.value)
+}
+
+// js renders the object, wrapping persisted fields with the given $persist
+// expression. Field order follows the JSON encoding of the value.
+func (d DataObject) js(persist string) (string, error) {
+	raw, err := json.Marshal(d.value)
+	if err != nil {
+		return "", fmt.Errorf("alpine: serializing %T: %w", d.value, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return "", fmt.Errorf("alpine: %T does not serialize to an object", d.value)
+	}
+
+	seen := map[string]bool{}
+	parts := []string{}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+
+		field := tok.(string)
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return "", err
+		}
+
+		name, _ := json.Marshal(field)
+		entry := string(name) + ":" + string(value)
+
+		if f, ok := d.persist[field]; ok {
+			if f.storage != "" && !identPattern.MatchString(string(f.storage)) {
+				return "", fmt.Errorf("alpine: cannot persist %q: storage %q is not a JavaScript identifier", field, f.storage)
+			}
+
+			seen[field] = true
+			entry = string(name) + ":" + f.wrap(persist, string(value))
+		}
+
+		parts = append(parts, entry)
+	}
+
+	for field := range d.persist {
+		if !seen[field] {
+			return "", fmt.Errorf("alpine: cannot persist %q: %T has no such field", field, d.value)
+		}
+	}
+
+	return "{" + strings.Join(parts, ",") + "}", nil
+}
+
+func (f persistField) wrap(persist, value string) string {
+	s := persist + "(" + value + ")"
+
+	if f.key != "" {
+		key, _ := json.Marshal(f.key)
+		s += ".as(" + string(key) + ")"
+	}
+
+	if f.storage != "" {
+		s += ".using(" + string(f.storage) + ")"
+	}
+
+	return s
+}
+
+// PersistedKeys returns the storage keys persisted by a DataObject, or by a
+// component or store whose state is one, and nil for anything else.
+// Example: PersistedKeys(alpine.Component("prefs", alpine.Data(p).Persist("theme")))
+func PersistedKeys(v any) []string {
+	switch v := v.(type) {
+	case DataObject:
+		return v.PersistedKeys()
+	case interface{ data() any }:
+		return PersistedKeys(v.data())
+	}
+
+	return nil
+}
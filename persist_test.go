@@ -0,0 +1,71 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+)
+
+type prefs struct {
+	Theme   string `json:"theme"`
+	Sidebar bool   `json:"sidebar"`
+	Draft   string `json:"draft"`
+}
+
+func TestDataObjectPersist(t *testing.T) {
+	d := Data(prefs{Theme: "light"}).
+		Persist("theme").
+		Persist("sidebar", PersistAs("prefs-sidebar"), PersistUsing(SessionStorage))
+
+	got, err := JSObject(d, "reset() { this.theme = 'light' }")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"theme":$persist("light"),"sidebar":$persist(false).as("prefs-sidebar").using(sessionStorage),"draft":"", reset() { this.theme = 'light' }}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+
+	if keys := PersistedKeys(d); strings.Join(keys, ",") != "_x_theme,prefs-sidebar" {
+		t.Errorf("PersistedKeys = %v", keys)
+	}
+
+	if _, err := JSObject(Data(prefs{}).Persist("missing")); err == nil {
+		t.Error("expected error for unknown field")
+	}
+
+	if _, err := JSObject(Data(prefs{}).Persist("theme", PersistUsing("localStorage);alert(1"))); err == nil {
+		t.Error("expected error for a storage that is not an identifier")
+	}
+
+	got, err = JSObject(Data(prefs{}).Persist("draft", PersistUsing("cookieStorage")))
+	if err != nil || !strings.Contains(got, `.using(cookieStorage)`) {
+		t.Errorf("custom storage: %s, %v", got, err)
+	}
+}
+
+func TestDataObjectInRegistry(t *testing.T) {
+	reg := NewRegistry()
+	c := Component("prefs", Data(prefs{}).Persist("theme"))
+
+	if err := reg.Register(c, Store("ui", Data(prefs{}).Persist("sidebar"))); err != nil {
+		t.Fatal(err)
+	}
+
+	reg.StoreRef("ui", "sidebar")
+
+	js, err := reg.JS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{`"theme":Alpine.$persist("")`, `"sidebar":Alpine.$persist(false)`} {
+		if !strings.Contains(js, want) {
+			t.Errorf("missing %s in\n%s", want, js)
+		}
+	}
+
+	if keys := PersistedKeys(c); len(keys) != 1 || keys[0] != "_x_theme" {
+		t.Errorf("PersistedKeys = %v", keys)
+	}
+}
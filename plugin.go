@@ -0,0 +1,140 @@
+package alpine
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/plainkit/alpine/js"
+	"github.com/plainkit/html"
+)
+
+// Plugin identifies an official Alpine.js plugin.
+type Plugin string
+
+// Official plugins, in the order their scripts are loaded.
+const (
+	PluginMask      Plugin = "mask"
+	PluginIntersect Plugin = "intersect"
+	PluginPersist   Plugin = "persist"
+	PluginFocus     Plugin = "focus"
+	PluginCollapse  Plugin = "collapse"
+	PluginMorph     Plugin = "morph"
+	PluginAnchor    Plugin = "anchor"
+	PluginSort      Plugin = "sort"
+	PluginResize    Plugin = "resize"
+)
+
+// Plugins lists every official plugin in load order.
+var Plugins = []Plugin{
+	PluginMask,
+	PluginIntersect,
+	PluginPersist,
+	PluginFocus,
+	PluginCollapse,
+	PluginMorph,
+	PluginAnchor,
+	PluginSort,
+	PluginResize,
+}
+
+// coreFileName is the name the core library is served under.
+const coreFileName = "alpine.min.js"
+
+// FileName returns the name the plugin is served under, e.g. "focus.min.js".
+func (p Plugin) FileName() string {
+	return string(p) + ".min.js"
+}
+
+// PluginJavaScript returns the embedded build of an official plugin,
+// or nil if the plugin is not bundled with this module.
+func PluginJavaScript(p Plugin) []byte {
+	return js.Plugin(string(p))
+}
+
+// Scripts returns deferred <script> tags for the given plugins followed by
+// Alpine itself, as Alpine requires plugins to load before the core.
+// Plugins are deduplicated and emitted in the order of Plugins regardless
+// of argument order; PluginMorph also loads the MorphHandler client.
+// Every file carries an integrity hash. prefix is the URL path FileServer
+// is mounted at.
+//
+// Scripts panics if a requested plugin is unknown or not bundled (see
+// PluginJavaScript), rather than emitting a tag FileServer cannot serve.
+//
+//	html.Head(alpine.Scripts("/js", alpine.PluginFocus, alpine.PluginCollapse))
+func Scripts(prefix string, plugins ...Plugin) html.ChildOpt {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	for _, p := range plugins {
+		if !slices.Contains(Plugins, p) {
+			panic("alpine: unknown plugin " + string(p))
+		}
+	}
+
+	var tags []html.Component
+
+	for _, p := range Plugins {
+		for _, want := range plugins {
+			if p == want {
+				tags = append(tags, fileScript(prefix, p.FileName()))
+				if p == PluginMorph {
+					tags = append(tags, fileScript(prefix, morphClientFileName))
+				}
+
+				break
+			}
+		}
+	}
+
+	tags = append(tags, fileScript(prefix, coreFileName))
+
+	return html.Fragment(tags...)
+}
+
+// fileScript returns the tag loading a FileServer file with its integrity
+// hash. It panics if FileServer does not serve the file.
+func fileScript(prefix, name string) html.Node {
+	a, ok := fileAssets()[name]
+	if !ok {
+		panic("alpine: " + name + " is not bundled; run go generate ./js")
+	}
+
+	return IntegrityScript(prefix+"/"+name, a.sri())
+}
+
+// FileServer serves Alpine, the embedded plugins and the morph client under
+// the names used by Scripts, with the same caching and compression as Handler.
+// The names are not fingerprinted, so responses are revalidated with the ETag.
+// Mount it with the prefix stripped:
+//
+//	http.Handle("/js/", http.StripPrefix("/js", alpine.FileServer()))
+func FileServer() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, ok := fileAssets()[strings.TrimPrefix(r.URL.Path, "/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		a.ServeHTTP(w, r)
+	})
+}
+
+// fileAssets maps the names served by FileServer to their assets.
+// Plugins that are not bundled are left out.
+var fileAssets = sync.OnceValue(func() map[string]*asset {
+	assets := map[string]*asset{
+		coreFileName:        coreAsset,
+		morphClientFileName: newAsset(MorphClientJavaScript(), nil),
+	}
+
+	for _, p := range Plugins {
+		if body := PluginJavaScript(p); body != nil {
+			assets[p.FileName()] = newAsset(body, nil)
+		}
+	}
+
+	return assets
+})
@@ -0,0 +1,85 @@
+package alpine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+// requirePlugins stops the test unless the given plugins are embedded.
+func requirePlugins(t *testing.T, plugins ...Plugin) {
+	t.Helper()
+
+	for _, p := range plugins {
+		if PluginJavaScript(p) == nil {
+			t.Fatalf("%s is not vendored; run go generate ./js", p.FileName())
+		}
+	}
+}
+
+func TestPluginsEmbedded(t *testing.T) {
+	for _, p := range Plugins {
+		body := PluginJavaScript(p)
+		if body == nil {
+			t.Errorf("%s is not vendored; run go generate ./js", p.FileName())
+			continue
+		}
+
+		if _, ok := fileAssets()[p.FileName()]; !ok {
+			t.Errorf("FileServer does not serve %s", p.FileName())
+		}
+	}
+}
+
+func TestScriptsOrder(t *testing.T) {
+	requirePlugins(t, PluginCollapse, PluginFocus)
+
+	out := html.Render(html.Head(Scripts("/js/", PluginCollapse, PluginFocus, PluginCollapse)))
+
+	focus := strings.Index(out, `src="/js/focus.min.js"`)
+	collapse := strings.Index(out, `src="/js/collapse.min.js"`)
+	core := strings.Index(out, `src="/js/alpine.min.js"`)
+
+	if focus < 0 || collapse < focus || core < collapse || strings.Count(out, "<script") != 3 {
+		t.Errorf("unexpected scripts:\n%s", out)
+	}
+
+	if !strings.Contains(out, JavaScriptSRI().SHA384) {
+		t.Error("core script has no integrity attribute")
+	}
+}
+
+func TestScriptsRejectsUnknownPlugin(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Scripts accepted a plugin FileServer cannot serve")
+		}
+	}()
+
+	Scripts("/js", Plugin("tooltip"))
+}
+
+func TestFileServer(t *testing.T) {
+	srv := FileServer()
+
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/alpine.min.js", nil))
+
+	if rec.Code != http.StatusOK || rec.Body.Len() != len(JavaScript()) {
+		t.Errorf("core: status %d, %d bytes", rec.Code, rec.Body.Len())
+	}
+
+	if got := rec.Header().Get("Cache-Control"); got != "no-cache" {
+		t.Errorf("core: Cache-Control = %q", got)
+	}
+
+	rec = httptest.NewRecorder()
+	srv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jquery.min.js", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("unknown file: status %d", rec.Code)
+	}
+}
@@ -0,0 +1,202 @@
+package alpine
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/plainkit/html"
+)
+
+// Registry collects the Alpine registrations used by a page and renders
+// them as a single <script> block that runs on the alpine:init event.
+// Output is sorted by name, so the same registrations always render the
+// same bytes. A Registry is not safe for concurrent use.
+type Registry struct {
+	data   map[string]string
+	stores map[string]store
+	binds  map[string]string
+	refs   []storeRef
+}
+
+// Registration is a definition that can be added to a Registry, such as a
+// component created with Component, a store created with Store or a
+// directive set created with Bind.
+type Registration interface {
+	register(r *Registry) error
+}
+
+type store struct {
+	js     string
+	fields map[string]bool // nil for stores holding a primitive value
+}
+
+type storeRef struct {
+	name string
+	path []string
+}
+
+var identPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		data:   map[string]string{},
+		stores: map[string]store{},
+		binds:  map[string]string{},
+	}
+}
+
+// Register adds definitions to the registry.
+// It fails if a name is invalid or already registered.
+func (r *Registry) Register(regs ...Registration) error {
+	for _, reg := range regs {
+		if err := reg.register(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StoreRef returns a $store expression such as "$store.cart.items" for use
+// in directives. The reference is checked when the registry is rendered, so
+// the store may be registered before or after the call.
+// Example: XText(reg.StoreRef("cart", "count"))
+func (r *Registry) StoreRef(name string, path ...string) string {
+	r.refs = append(r.refs, storeRef{name: name, path: path})
+
+	return strings.Join(append([]string{"$store", name}, path...), ".")
+}
+
+// Err reports the first $store reference that does not match a registered
+// store or one of its top-level fields and methods.
+func (r *Registry) Err() error {
+	for _, ref := range r.refs {
+		s, ok := r.stores[ref.name]
+		if !ok {
+			return fmt.Errorf("alpine: $store.%s references an unregistered store", ref.name)
+		}
+
+		if len(ref.path) == 0 {
+			continue
+		}
+
+		if s.fields == nil || !s.fields[ref.path[0]] {
+			return fmt.Errorf("alpine: $store.%s has no field %q", ref.name, ref.path[0])
+		}
+	}
+
+	return nil
+}
+
+// JS returns the body of the registration script. Stores are registered
+// first so component init code can read them, then Alpine.bind sets, then
+// components.
+// It fails if any StoreRef does not resolve.
+func (r *Registry) JS() (string, error) {
+	if err := r.Err(); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("document.addEventListener('alpine:init', () => {\n")
+
+	for _, name := range sortedNames(r.stores) {
+		fmt.Fprintf(&sb, "Alpine.store(%s, %s);\n", strconv.Quote(name), r.stores[name].js)
+	}
+
+	for _, name := range sortedNames(r.binds) {
+		fmt.Fprintf(&sb, "Alpine.bind(%s, %s);\n", strconv.Quote(name), r.binds[name])
+	}
+
+	for _, name := range sortedNames(r.data) {
+		fmt.Fprintf(&sb, "Alpine.data(%s, %s);\n", strconv.Quote(name), r.data[name])
+	}
+
+	sb.WriteString("});")
+
+	return sb.String(), nil
+}
+
+// Script returns the registrations as an inline <script> element.
+// Render it before the Alpine script tag, or anywhere if Alpine is deferred.
+func (r *Registry) Script() (html.Node, error) {
+	return r.ScriptContext(context.Background())
+}
+
+// ScriptContext is like Script but sets the nonce stored in ctx by WithNonce
+// on the element, for pages served with a nonce-based CSP.
+func (r *Registry) ScriptContext(ctx context.Context) (html.Node, error) {
+	js, err := r.JS()
+	if err != nil {
+		return html.Node{}, err
+	}
+
+	return html.Script(html.ANonce(Nonce(ctx)), html.UnsafeText(js)), nil
+}
+
+func (r *Registry) addData(name, factory string) error {
+	if err := checkName("component", name); err != nil {
+		return err
+	}
+
+	if _, ok := r.data[name]; ok {
+		return fmt.Errorf("alpine: component %q is already registered", name)
+	}
+
+	r.data[name] = factory
+
+	return nil
+}
+
+func (r *Registry) addStore(name string, s store) error {
+	if err := checkName("store", name); err != nil {
+		return err
+	}
+
+	if _, ok := r.stores[name]; ok {
+		return fmt.Errorf("alpine: store %q is already registered", name)
+	}
+
+	r.stores[name] = s
+
+	return nil
+}
+
+func (r *Registry) addBind(name, factory string) error {
+	if err := checkName("bind", name); err != nil {
+		return err
+	}
+
+	if _, ok := r.binds[name]; ok {
+		return fmt.Errorf("alpine: bind %q is already registered", name)
+	}
+
+	r.binds[name] = factory
+
+	return nil
+}
+
+func checkName(kind, name string) error {
+	if !identPattern.MatchString(name) {
+		return fmt.Errorf("alpine: %s name %q is not a valid JavaScript identifier", kind, name)
+	}
+
+	return nil
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
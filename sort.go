@@ -0,0 +1,84 @@
+package alpine
+
+import (
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// Sort plugin directives. They require PluginSort to be loaded (see Scripts).
+
+// Magic variables available to x-sort handlers.
+const (
+	SortItem     = "$item"     // the x-sort:item key of the moved element
+	SortPosition = "$position" // its new zero-based index
+)
+
+// SortCall returns a handler calling method with the moved item and its new position.
+// Example: XSort(SortCall("reorder")) produces x-sort="reorder($item, $position)"
+func SortCall(method string) string {
+	return method + "(" + SortItem + ", " + SortPosition + ")"
+}
+
+// XSort makes the element's children sortable and runs handler after a move.
+// Example: XSort(SortCall("reorder"))
+func XSort[E Expression](handler E) html.Global {
+	return directive("x-sort", handler)
+}
+
+// XSortItem sets the key passed to the handler as $item.
+// Example: XSortItem("todo.id")
+func XSortItem[E Expression](key E) html.Global {
+	return directive("x-sort:item", key)
+}
+
+// XSortHandle restricts dragging to this element within an item.
+// Like XCollapse, it renders with the value "true" so html.Render keeps it.
+func XSortHandle() html.Global {
+	return html.ACustom("x-sort:handle", "true")
+}
+
+// XSortGroup lets items be dragged between lists sharing the same group name.
+// Example: XSortGroup("todos")
+func XSortGroup(name string) html.Global {
+	return html.ACustom("x-sort:group", name)
+}
+
+// XSortIgnore prevents the element from starting a drag.
+func XSortIgnore() html.Global {
+	return html.ACustom("x-sort:ignore", "true")
+}
+
+// XSortConfig passes options through to SortableJS.
+// Example: XSortConfig("{ animation: 0 }")
+func XSortConfig[E Expression](config E) html.Global {
+	return directive("x-sort:config", config)
+}
+
+// Sorting builds an x-sort directive with modifiers.
+//
+//	alpine.Sortable().Ghost().Handler(alpine.SortCall("reorder"))
+//	// x-sort.ghost="reorder($item, $position)"
+type Sorting struct {
+	modifiers modifiers
+}
+
+// Sortable starts an x-sort builder.
+func Sortable() Sorting {
+	return Sorting{}
+}
+
+// Ghost leaves a ghost copy in the original position while dragging.
+func (s Sorting) Ghost() Sorting {
+	s.modifiers = s.modifiers.with("ghost", "")
+	return s
+}
+
+// Handler renders x-sort with the configured modifiers.
+func (s Sorting) Handler(handler string) html.Global {
+	return directive("x-sort"+s.modifiers.String(), handler)
+}
+
+// HandlerExpr is Handler for an expression built with package expr.
+func (s Sorting) HandlerExpr(handler expr.Expr) html.Global {
+	return directive("x-sort"+s.modifiers.String(), handler)
+}
@@ -0,0 +1,37 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/plainkit/html"
+)
+
+func TestSortDirectives(t *testing.T) {
+	out := renderAttrs(
+		Sortable().Ghost().Handler(SortCall("reorder")),
+		XSortItem("todo.id"),
+		XSortGroup("todos"),
+		XSortConfig("{ animation: 0 }"),
+	)
+
+	for _, want := range []string{
+		`x-sort.ghost="reorder($item, $position)"`,
+		`x-sort:item="todo.id"`,
+		`x-sort:group="todos"`,
+		`x-sort:config="{ animation: 0 }"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %s in %s", want, out)
+		}
+	}
+
+	for want, g := range map[string]html.Global{
+		`<div x-sort:handle="true"></div>`: XSortHandle(),
+		`<div x-sort:ignore="true"></div>`: XSortIgnore(),
+	} {
+		if got := renderAttrs(g); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
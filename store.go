@@ -0,0 +1,76 @@
+package alpine
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// StoreDef is a global store registered with Alpine.store.
+type StoreDef[T any] struct {
+	name    string
+	value   T
+	methods []string
+}
+
+// memberName extracts the name of a raw object member such as
+// "toggle() {...}", "get total() {...}" or "async load() {...}".
+var memberName = regexp.MustCompile(`^(?:(?:get|set|async)\s+)?([A-Za-z_$][A-Za-z0-9_$]*)`)
+
+// Store defines a global store named name. Objects are serialized as with
+// JSObject and may carry raw JavaScript methods; other values such as
+// booleans or strings are stored as-is and cannot have methods.
+// Add it to a Registry and reference it with Registry.StoreRef.
+//
+//	reg.Register(alpine.Store("cart", Cart{}, "add(item) { this.items.push(item) }"))
+//	alpine.XText(reg.StoreRef("cart", "items") + ".length")
+func Store[T any](name string, value T, methods ...string) StoreDef[T] {
+	return StoreDef[T]{name: name, value: value, methods: methods}
+}
+
+// Name returns the registered store name.
+func (s StoreDef[T]) Name() string {
+	return s.name
+}
+
+func (s StoreDef[T]) data() any {
+	return s.value
+}
+
+func (s StoreDef[T]) register(r *Registry) error {
+	raw, err := json.Marshal(s.value)
+	if err != nil {
+		return fmt.Errorf("alpine: serializing store %q: %w", s.name, err)
+	}
+
+	if raw[0] != '{' {
+		if len(trimMembers(s.methods)) > 0 {
+			return fmt.Errorf("alpine: store %q holds a %T and cannot have methods", s.name, s.value)
+		}
+
+		return r.addStore(s.name, store{js: string(raw)})
+	}
+
+	obj, err := jsObject(s.value, "Alpine.$persist", s.methods)
+	if err != nil {
+		return err
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return fmt.Errorf("alpine: serializing store %q: %w", s.name, err)
+	}
+
+	fields := make(map[string]bool, len(values)+len(s.methods))
+	for k := range values {
+		fields[k] = true
+	}
+
+	for _, m := range trimMembers(s.methods) {
+		if match := memberName.FindStringSubmatch(m); match != nil {
+			fields[match[1]] = true
+		}
+	}
+
+	return r.addStore(s.name, store{js: obj, fields: fields})
+}
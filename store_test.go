@@ -0,0 +1,71 @@
+package alpine
+
+import (
+	"strings"
+	"testing"
+)
+
+type cart struct {
+	Items []string `json:"items"`
+	Open  bool     `json:"open"`
+}
+
+func TestRegistryStores(t *testing.T) {
+	reg := NewRegistry()
+
+	if got := reg.StoreRef("cart", "items"); got != "$store.cart.items" {
+		t.Errorf("StoreRef = %q", got)
+	}
+
+	reg.StoreRef("cart", "clear")
+	reg.StoreRef("darkMode")
+
+	err := reg.Register(
+		Store("darkMode", true),
+		Store("cart", cart{Items: []string{}}, "clear() { this.items = [] }"),
+		Component("panel", struct{}{}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	js, err := reg.JS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `Alpine.store("cart", {"items":[],"open":false, clear() { this.items = [] }});
+Alpine.store("darkMode", true);
+Alpine.data("panel"`
+	if !strings.Contains(js, want) {
+		t.Errorf("unexpected script:\n%s", js)
+	}
+}
+
+func TestRegistryRejectsUnknownStoreRefs(t *testing.T) {
+	tests := []struct {
+		name string
+		path []string
+	}{
+		{"missing", nil},
+		{"cart", []string{"total"}},
+		{"darkMode", []string{"value"}},
+	}
+
+	for _, tt := range tests {
+		reg := NewRegistry()
+		if err := reg.Register(Store("cart", cart{}), Store("darkMode", false)); err != nil {
+			t.Fatal(err)
+		}
+
+		reg.StoreRef(tt.name, tt.path...)
+
+		if _, err := reg.Script(); err == nil {
+			t.Errorf("StoreRef(%q, %v): expected render error", tt.name, tt.path)
+		}
+	}
+
+	if err := NewRegistry().Register(Store("flag", false, "toggle() {}")); err == nil {
+		t.Error("expected error for methods on a primitive store")
+	}
+}
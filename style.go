@@ -0,0 +1,369 @@
+package alpine
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/plainkit/alpine/expr"
+	"github.com/plainkit/html"
+)
+
+// StyleMap builds the object bound by :style, mapping CSS properties to
+// expressions or literal values.
+//
+//	alpine.Styles().Set("max-height", 240).Bind("backgroundColor", "color").XBindStyle()
+//	// x-bind:style="{backgroundColor: color, maxHeight: '240px'}"
+//
+// Property names may be given in camelCase or kebab-case; they render in
+// camelCase, sorted. Custom properties ("--gap") are kept as is, and
+// vendor-prefixed ones render quoted in kebab-case ('-webkit-line-clamp'),
+// since Alpine would turn WebkitLineClamp into webkit-line-clamp.
+type StyleMap struct {
+	entries []styleEntry
+	strict  bool
+	err     error
+}
+
+type styleEntry struct {
+	property string // camelCase, or a custom or vendor-prefixed property
+	value    string // JavaScript expression
+}
+
+var propertyPattern = regexp.MustCompile(`^(--[A-Za-z0-9_-]+|-?[A-Za-z][A-Za-z0-9-]*)$`)
+
+// Styles starts a StyleMap.
+func Styles() StyleMap {
+	return StyleMap{}
+}
+
+// Strict rejects property names that are not standard CSS properties.
+// Custom and vendor-prefixed properties are always allowed.
+func (s StyleMap) Strict() StyleMap {
+	s.strict = true
+	for _, e := range s.entries {
+		s = s.check(e.property)
+	}
+
+	return s
+}
+
+// Bind sets property to a JavaScript expression evaluated by Alpine.
+func (s StyleMap) Bind(property, expression string) StyleMap {
+	if strings.TrimSpace(expression) == "" {
+		s.err = fmt.Errorf("alpine: style property %q has no expression", property)
+		return s
+	}
+
+	return s.add(property, expression)
+}
+
+// BindExpr is Bind for an expression built with package expr.
+func (s StyleMap) BindExpr(property string, expression expr.Expr) StyleMap {
+	return s.Bind(property, expression.String())
+}
+
+// Set sets property to a literal value. Strings are quoted; integers and
+// floats get a px unit unless the property is unitless (such as opacity or
+// zIndex); durations are written in milliseconds.
+func (s StyleMap) Set(property string, value any) StyleMap {
+	key := styleKey(property)
+	camel := camelProperty(strings.TrimPrefix(key, vendorPrefix(key)))
+
+	var v string
+
+	switch x := value.(type) {
+	case string:
+		v = x
+	case time.Duration:
+		v = strconv.FormatInt(x.Milliseconds(), 10) + "ms"
+	case int:
+		v = withUnit(camel, strconv.Itoa(x))
+	case int64:
+		v = withUnit(camel, strconv.FormatInt(x, 10))
+	case float64:
+		v = withUnit(camel, strconv.FormatFloat(x, 'f', -1, 64))
+	case float32:
+		v = withUnit(camel, strconv.FormatFloat(float64(x), 'f', -1, 32))
+	default:
+		s.err = fmt.Errorf("alpine: style property %q has unsupported value type %T", property, value)
+		return s
+	}
+
+	return s.add(property, singleQuote(v))
+}
+
+// Err reports the first invalid property or value.
+func (s StyleMap) Err() error {
+	return s.err
+}
+
+// Object returns the JavaScript object literal, e.g. "{opacity: '0.5'}".
+// A property set more than once keeps its last value.
+func (s StyleMap) Object() string {
+	values := map[string]string{}
+	for _, e := range s.entries {
+		values[e.property] = e.value
+	}
+
+	parts := make([]string, 0, len(values))
+	for _, property := range sortedNames(values) {
+		key := property
+		if !identPattern.MatchString(key) {
+			key = singleQuote(key)
+		}
+
+		parts = append(parts, key+": "+values[property])
+	}
+
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// XBindStyle renders the map as x-bind:style.
+// It panics if the map is invalid; use Err to check first.
+func (s StyleMap) XBindStyle() html.Global {
+	if s.err != nil {
+		panic(s.err)
+	}
+
+	return XBindStyle(s.Object())
+}
+
+// ColonStyle renders the map as :style.
+// It panics if the map is invalid; use Err to check first.
+func (s StyleMap) ColonStyle() html.Global {
+	if s.err != nil {
+		panic(s.err)
+	}
+
+	return ColonStyle(s.Object())
+}
+
+func (s StyleMap) add(property, value string) StyleMap {
+	if !propertyPattern.MatchString(property) {
+		s.err = fmt.Errorf("alpine: %q is not a CSS property name", property)
+		return s
+	}
+
+	key := styleKey(property)
+	s.entries = append(s.entries[:len(s.entries):len(s.entries)], styleEntry{property: key, value: value})
+
+	return s.check(key)
+}
+
+// check records an error if strict mode is on and property is unknown.
+func (s StyleMap) check(property string) StyleMap {
+	if !s.strict || s.err != nil || strings.HasPrefix(property, "--") {
+		return s
+	}
+
+	name := kebabProperty(property)
+	if vendorPrefix(name) != "" {
+		return s
+	}
+
+	if !cssProperties[name] {
+		s.err = fmt.Errorf("alpine: %q is not a known CSS property", property)
+	}
+
+	return s
+}
+
+// styleKey returns the key property is rendered under: camelCase, except
+// for custom properties and vendor-prefixed ones, which stay kebab-case.
+// Both "-webkit-mask" and "WebkitMask" become "-webkit-mask".
+func styleKey(property string) string {
+	if strings.HasPrefix(property, "--") {
+		return property
+	}
+
+	camel := camelProperty(property)
+	if kebab := kebabProperty(camel); vendorPrefix(kebab) != "" {
+		return kebab
+	}
+
+	return camel
+}
+
+// vendorPrefix returns the vendor prefix of a kebab-case property, such as
+// "-webkit-", or "" if it has none.
+func vendorPrefix(property string) string {
+	for _, prefix := range []string{"-webkit-", "-moz-", "-ms-"} {
+		if strings.HasPrefix(property, prefix) {
+			return prefix
+		}
+	}
+
+	return ""
+}
+
+// camelProperty converts "max-height" to "maxHeight" and "-webkit-mask" to
+// "WebkitMask". Custom properties are returned unchanged.
+func camelProperty(property string) string {
+	if strings.HasPrefix(property, "--") {
+		return property
+	}
+
+	parts := strings.Split(property, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// kebabProperty converts "maxHeight" to "max-height" and "WebkitMask" to
+// "-webkit-mask".
+func kebabProperty(property string) string {
+	var sb strings.Builder
+
+	for _, r := range property {
+		if r >= 'A' && r <= 'Z' {
+			sb.WriteByte('-')
+			r += 'a' - 'A'
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// withUnit appends px to a number unless property takes plain numbers.
+func withUnit(property, number string) string {
+	if unitless[property] {
+		return number
+	}
+
+	return number + "px"
+}
+
+// singleQuote returns s as a single-quoted JavaScript string literal.
+// Like encoding/json, it escapes <, > and & so the literal is also safe
+// inside an inline <script> element, as in Registry.Script.
+func singleQuote(s string) string {
+	return "'" + singleQuoteEscaper.Replace(s) + "'"
+}
+
+var singleQuoteEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\u2028", `\u2028`,
+	"\u2029", `\u2029`,
+	"<", `\u003c`,
+	">", `\u003e`,
+	"&", `\u0026`,
+)
+
+// unitless lists the properties whose numeric values take no unit.
+var unitless = map[string]bool{
+	"animationIterationCount": true,
+	"aspectRatio":             true,
+	"columnCount":             true,
+	"columns":                 true,
+	"fillOpacity":             true,
+	"flex":                    true,
+	"flexGrow":                true,
+	"flexShrink":              true,
+	"fontWeight":              true,
+	"gridArea":                true,
+	"gridColumn":              true,
+	"gridColumnEnd":           true,
+	"gridColumnStart":         true,
+	"gridRow":                 true,
+	"gridRowEnd":              true,
+	"gridRowStart":            true,
+	"lineClamp":               true,
+	"lineHeight":              true,
+	"opacity":                 true,
+	"order":                   true,
+	"orphans":                 true,
+	"scale":                   true,
+	"stopOpacity":             true,
+	"strokeOpacity":           true,
+	"tabSize":                 true,
+	"widows":                  true,
+	"zIndex":                  true,
+	"zoom":                    true,
+}
+
+// cssProperties is the set of standard CSS properties accepted by Strict.
+var cssProperties = func() map[string]bool {
+	set := map[string]bool{}
+	for _, p := range strings.Fields(`
+		accent-color align-content align-items align-self all animation
+		animation-delay animation-direction animation-duration
+		animation-fill-mode animation-iteration-count animation-name
+		animation-play-state animation-timing-function appearance aspect-ratio
+		backdrop-filter backface-visibility background background-attachment
+		background-blend-mode background-clip background-color background-image
+		background-origin background-position background-position-x
+		background-position-y background-repeat background-size block-size
+		border border-block border-block-end border-block-start border-bottom
+		border-bottom-color border-bottom-left-radius border-bottom-right-radius
+		border-bottom-style border-bottom-width border-collapse border-color
+		border-end-end-radius border-end-start-radius border-image
+		border-inline border-inline-end border-inline-start border-left
+		border-left-color border-left-style border-left-width border-radius
+		border-right border-right-color border-right-style border-right-width
+		border-spacing border-start-end-radius border-start-start-radius
+		border-style border-top border-top-color border-top-left-radius
+		border-top-right-radius border-top-style border-top-width border-width
+		bottom box-decoration-break box-shadow box-sizing break-after
+		break-before break-inside caption-side caret-color clear clip clip-path
+		color color-scheme column-count column-fill column-gap column-rule
+		column-span column-width columns contain container container-name
+		container-type content content-visibility counter-increment
+		counter-reset counter-set cursor direction display empty-cells fill
+		fill-opacity filter flex flex-basis flex-direction flex-flow flex-grow
+		flex-shrink flex-wrap float font font-family font-feature-settings
+		font-kerning font-size font-size-adjust font-stretch font-style
+		font-variant font-variant-numeric font-variation-settings font-weight
+		gap grid grid-area grid-auto-columns grid-auto-flow grid-auto-rows
+		grid-column grid-column-end grid-column-start grid-row grid-row-end
+		grid-row-start grid-template grid-template-areas grid-template-columns
+		grid-template-rows height hyphens image-rendering inline-size inset
+		inset-block inset-block-end inset-block-start inset-inline
+		inset-inline-end inset-inline-start isolation justify-content
+		justify-items justify-self left letter-spacing line-clamp line-height
+		list-style list-style-image list-style-position list-style-type margin
+		margin-block margin-block-end margin-block-start margin-bottom
+		margin-inline margin-inline-end margin-inline-start margin-left
+		margin-right margin-top mask mask-image mask-position mask-repeat
+		mask-size max-block-size max-height max-inline-size max-width
+		min-block-size min-height min-inline-size min-width mix-blend-mode
+		object-fit object-position offset opacity order orphans outline
+		outline-color outline-offset outline-style outline-width overflow
+		overflow-wrap overflow-x overflow-y overscroll-behavior
+		overscroll-behavior-x overscroll-behavior-y padding padding-block
+		padding-block-end padding-block-start padding-bottom padding-inline
+		padding-inline-end padding-inline-start padding-left padding-right
+		padding-top page-break-after page-break-before page-break-inside
+		perspective perspective-origin place-content place-items place-self
+		pointer-events position quotes resize right rotate row-gap scale
+		scroll-behavior scroll-margin scroll-padding scroll-snap-align
+		scroll-snap-stop scroll-snap-type scrollbar-color scrollbar-gutter
+		scrollbar-width shape-outside stop-color stop-opacity stroke
+		stroke-dasharray stroke-dashoffset stroke-linecap stroke-linejoin
+		stroke-opacity stroke-width tab-size table-layout text-align
+		text-align-last text-decoration text-decoration-color
+		text-decoration-line text-decoration-style text-decoration-thickness
+		text-indent text-overflow text-rendering text-shadow text-transform
+		text-underline-offset text-wrap top touch-action transform
+		transform-origin transform-style transition transition-behavior
+		transition-delay transition-duration transition-property
+		transition-timing-function translate unicode-bidi user-select
+		vertical-align view-transition-name visibility white-space widows
+		width will-change word-break word-spacing writing-mode z-index zoom
+	`) {
+		set[p] = true
+	}
+
+	return set
+}()
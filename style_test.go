@@ -0,0 +1,66 @@
+package alpine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStyleMap(t *testing.T) {
+	tests := map[string]StyleMap{
+		"{}":                                        Styles(),
+		"{backgroundColor: color}":                  Styles().Bind("background-color", "color"),
+		"{maxHeight: '240px', opacity: '0.5'}":      Styles().Set("opacity", 0.5).Set("maxHeight", 240),
+		"{zIndex: '10'}":                            Styles().Set("z-index", 10),
+		"{transitionDuration: '150ms'}":             Styles().Set("transition-duration", 150*time.Millisecond),
+		`{fontFamily: 'Tom\'s \\ font'}`:            Styles().Set("font-family", `Tom's \ font`),
+		"{'--gap': '4px', '-webkit-line-clamp': n}": Styles().Set("--gap", "4px").Bind("-webkit-line-clamp", "n"),
+		"{'-webkit-line-clamp': '3'}":               Styles().Set("WebkitLineClamp", 3),
+		"{'-moz-user-select': 'none'}":              Styles().Set("-moz-user-select", "none"),
+		"{width: w}":                                Styles().Set("width", 10).Bind("width", "w"),
+	}
+
+	for want, s := range tests {
+		if err := s.Err(); err != nil {
+			t.Errorf("%s: %v", want, err)
+		}
+
+		if got := s.Object(); got != want {
+			t.Errorf("Object() = %s, want %s", got, want)
+		}
+	}
+
+	if got := customAttrs(Styles().Bind("color", "c").ColonStyle())[":style"]; got != "{color: c}" {
+		t.Errorf(":style = %s", got)
+	}
+}
+
+func TestStyleMapErr(t *testing.T) {
+	tests := map[string]StyleMap{
+		"strict unknown":   Styles().Strict().Set("colour", "red"),
+		"strict after set": Styles().Set("colour", "red").Strict(),
+		"bad name":         Styles().Set("color;", "red"),
+		"empty expression": Styles().Bind("color", " "),
+		"bad value":        Styles().Set("color", true),
+	}
+
+	for name, s := range tests {
+		if s.Err() == nil {
+			t.Errorf("%s: expected error", name)
+		}
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: XBindStyle did not panic", name)
+				}
+			}()
+
+			s.XBindStyle()
+		}()
+	}
+
+	ok := Styles().Strict().Set("maxHeight", 1).Set("--x", 1).Bind("-webkit-mask", "m")
+	if err := ok.Err(); err != nil {
+		t.Errorf("strict: %v", err)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build alpine_v3_14
+
+package alpine
+
+import "testing"
+
+func TestVersionV3_14(t *testing.T) {
+	if Version != "3.14.9" {
+		t.Errorf("Version = %q, want 3.14.9 with -tags alpine_v3_14", Version)
+	}
+}
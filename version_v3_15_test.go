@@ -0,0 +1,11 @@
+//go:build !alpine_v3_14
+
+package alpine
+
+import "testing"
+
+func TestVersionDefault(t *testing.T) {
+	if Version != "3.15.0" {
+		t.Errorf("Version = %q, want 3.15.0 without -tags alpine_v3_14", Version)
+	}
+}